@@ -0,0 +1,235 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	log "github.com/Sirupsen/logrus"
+	"github.com/bytesizedhosting/bcd/core"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	fileName = "audit.log"
+
+	// The log is rotated once it grows past DefaultMaxSize bytes, only the
+	// last DefaultKeep rotated files are kept.
+	DefaultMaxSize = 10 * 1024 * 1024
+	DefaultKeep    = 5
+
+	// Most entries returned by one query.
+	MaxResults = 1000
+)
+
+type Entry struct {
+	Time       time.Time   `json:"time"`
+	Token      string      `json:"token"`
+	Address    string      `json:"address"`
+	Method     string      `json:"method"`
+	Args       interface{} `json:"args,omitempty"`
+	Success    bool        `json:"success"`
+	Error      string      `json:"error,omitempty"`
+	JobId      string      `json:"job_id,omitempty"`
+	DurationMs int64       `json:"duration_ms"`
+}
+
+// Filter selects entries in Query, empty fields match everything. Method is
+// either a full method name or a whole service like "DelugeRPC.*".
+type Filter struct {
+	Since  time.Time `json:"since,omitempty"`
+	Until  time.Time `json:"until,omitempty"`
+	Method string    `json:"method,omitempty"`
+	Limit  int       `json:"limit,omitempty"`
+}
+
+func (self *Filter) Match(entry *Entry) bool {
+	if !self.Since.IsZero() && entry.Time.Before(self.Since) {
+		return false
+	}
+	if !self.Until.IsZero() && entry.Time.After(self.Until) {
+		return false
+	}
+	if self.Method == "" || self.Method == entry.Method {
+		return true
+	}
+	return strings.HasSuffix(self.Method, ".*") && strings.HasPrefix(entry.Method, strings.TrimSuffix(self.Method, "*"))
+}
+
+// Log is an append-only file of JSON entries, one per line.
+type Log struct {
+	folder  string
+	file    *os.File
+	size    int64
+	MaxSize int64
+	Keep    int
+	lock    sync.Mutex
+	// Held for reading while Query scans the files, so only a rotation waits
+	// for a query and plain writes do not.
+	rotation sync.RWMutex
+}
+
+var Default = &Log{MaxSize: DefaultMaxSize, Keep: DefaultKeep}
+
+// Open starts appending to the audit log in folder. Entries written before
+// the log is opened are dropped.
+func (self *Log) Open(folder string) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	err := core.EnsurePath(folder)
+	if err != nil {
+		return err
+	}
+	self.folder = folder
+	return self.openFile()
+}
+
+func (self *Log) Write(entry *Entry) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if self.file == nil {
+		return
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		log.Warnln("Could not encode audit log entry:", err)
+		return
+	}
+	data = append(data, '\n')
+
+	if self.MaxSize > 0 && self.size+int64(len(data)) > self.MaxSize {
+		err = self.rotate()
+		if err != nil {
+			log.Warnln("Could not rotate the audit log:", err)
+		}
+		if self.file == nil {
+			return
+		}
+	}
+
+	n, err := self.file.Write(data)
+	self.size += int64(n)
+	if err != nil {
+		log.Warnln("Could not write to the audit log:", err)
+	}
+}
+
+// Query returns the entries matching filter, oldest first. When more than
+// the limit match only the newest are returned.
+func (self *Log) Query(filter Filter) ([]*Entry, error) {
+	self.lock.Lock()
+	folder, keep := self.folder, self.Keep
+	self.lock.Unlock()
+
+	if folder == "" {
+		return nil, fmt.Errorf("The audit log is not enabled")
+	}
+
+	self.rotation.RLock()
+	defer self.rotation.RUnlock()
+
+	limit := filter.Limit
+	if limit <= 0 || limit > MaxResults {
+		limit = MaxResults
+	}
+
+	entries := []*Entry{}
+	// Rotated files hold older entries, start with the oldest one.
+	for i := keep; i >= 0; i-- {
+		found, err := self.readFile(filePath(folder, i), &filter)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, found...)
+		if len(entries) > limit {
+			entries = entries[len(entries)-limit:]
+		}
+	}
+
+	return entries, nil
+}
+
+func (self *Log) readFile(filePath string, filter *Filter) ([]*Entry, error) {
+	file, err := os.Open(filePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	entries := []*Entry{}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		entry := Entry{}
+		if json.Unmarshal(scanner.Bytes(), &entry) != nil {
+			continue
+		}
+		if filter.Match(&entry) {
+			entries = append(entries, &entry)
+		}
+	}
+	return entries, scanner.Err()
+}
+
+func (self *Log) filePath(n int) string {
+	return filePath(self.folder, n)
+}
+
+// filePath returns the path of the current log for 0 and of the rotated
+// files for higher numbers.
+func filePath(folder string, n int) string {
+	if n == 0 {
+		return path.Join(folder, fileName)
+	}
+	return path.Join(folder, fmt.Sprintf("%s.%d", fileName, n))
+}
+
+func (self *Log) openFile() error {
+	file, err := os.OpenFile(self.filePath(0), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	self.file = file
+	self.size = info.Size()
+	return nil
+}
+
+func (self *Log) rotate() error {
+	self.rotation.Lock()
+	defer self.rotation.Unlock()
+
+	self.file.Close()
+	self.file = nil
+
+	var renameErr error
+	os.Remove(self.filePath(self.Keep))
+	for i := self.Keep - 1; i >= 0; i-- {
+		err := os.Rename(self.filePath(i), self.filePath(i+1))
+		if err != nil && !os.IsNotExist(err) && renameErr == nil {
+			renameErr = err
+		}
+	}
+
+	// Keep logging to the current file even when renaming failed.
+	err := self.openFile()
+	if err != nil {
+		return err
+	}
+	return renameErr
+}
@@ -0,0 +1,84 @@
+package audit
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestRotateAndQuery(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bcdaudit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	l := &Log{MaxSize: 300, Keep: 2}
+	err = l.Open(dir)
+	if err != nil {
+		t.Fatal("Could not open audit log:", err)
+	}
+
+	start := time.Now()
+	for i := 0; i < 20; i++ {
+		method := "DelugeRPC.Install"
+		if i%2 == 1 {
+			method = "PlexRPC.Uninstall"
+		}
+		l.Write(&Entry{Time: start.Add(time.Duration(i) * time.Second), Token: "default", Method: method})
+	}
+
+	files, _ := ioutil.ReadDir(dir)
+	if len(files) != 3 {
+		t.Error("Expected the log and two rotated files, got", len(files))
+	}
+
+	entries, err := l.Query(Filter{Method: "PlexRPC.*", Since: start.Add(10 * time.Second)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) == 0 {
+		t.Fatal("Query did not return any entries")
+	}
+	for i, entry := range entries {
+		if entry.Method != "PlexRPC.Uninstall" || entry.Time.Before(start.Add(10*time.Second)) {
+			t.Error("Entry does not match the filter:", entry)
+		}
+		if i > 0 && entry.Time.Before(entries[i-1].Time) {
+			t.Error("Entries are not sorted oldest first")
+		}
+	}
+	if last := entries[len(entries)-1]; !last.Time.Equal(start.Add(19 * time.Second)) {
+		t.Error("Newest entry is missing, got:", last)
+	}
+}
+
+func TestWriteDuringQuery(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bcdaudit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	l := &Log{MaxSize: DefaultMaxSize, Keep: 2}
+	err = l.Open(dir)
+	if err != nil {
+		t.Fatal("Could not open audit log:", err)
+	}
+
+	// As a query scanning the files does.
+	l.rotation.RLock()
+	defer l.rotation.RUnlock()
+
+	written := make(chan bool)
+	go func() {
+		l.Write(&Entry{Time: time.Now(), Token: "default", Method: "DelugeRPC.Install"})
+		close(written)
+	}()
+	select {
+	case <-written:
+	case <-time.After(time.Second):
+		t.Fatal("A write waited for a query")
+	}
+}
@@ -20,7 +20,7 @@ var (
 	newPlugin  = app.Command("plugin", "Create a new plugin")
 	pluginName = newPlugin.Arg("name", "The name for plugin").Required().String()
 )
-var Blacklist = map[string]bool{"jobs": true, "stats": true, "proxy": true}
+var Blacklist = map[string]bool{"jobs": true, "stats": true, "proxy": true, "generic": true}
 
 type RpcTemplate struct {
 	Name      string
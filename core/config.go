@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/user"
 	"path"
+	"strings"
 )
 
 const (
@@ -32,6 +33,61 @@ type MainConfig struct {
 	ApiKey    string `json:"api_key"`
 	ApiSecret string `json:"api_secret"`
 	Port      string `json:"port"`
+	// Accept the API key and secret as basic auth next to signed requests,
+	// only meant for clients that have not moved to signing yet.
+	AllowBasicAuth bool `json:"allow_basic_auth,omitempty"`
+	// Certificate and key for the RPC listener, without them it serves plain
+	// HTTP.
+	TLSCert string `json:"tls_cert,omitempty"`
+	TLSKey  string `json:"tls_key,omitempty"`
+	// Extra credentials that may only call some methods, see Token.
+	Tokens []*Token `json:"tokens,omitempty"`
+	// Finished jobs are kept for this many hours, 0 uses the default of a week.
+	JobRetentionHours int `json:"job_retention_hours,omitempty"`
+	// How many jobs, like installs, can run at the same time.
+	MaxConcurrentJobs int `json:"max_concurrent_jobs,omitempty"`
+	// When EnabledPlugins is set only those plugins are activated, plugins in
+	// DisabledPlugins never are.
+	EnabledPlugins  []string `json:"enabled_plugins,omitempty"`
+	DisabledPlugins []string `json:"disabled_plugins,omitempty"`
+	// Registry asked about images that name no registry, Docker Hub when
+	// empty.
+	RegistryUrl string `json:"registry_url,omitempty"`
+	// Images are checked for updates every this many hours, 0 uses the
+	// default of 6 and a negative value turns the checks off.
+	UpdateCheckHours int `json:"update_check_hours,omitempty"`
+	// An app that dies CrashLoopDeaths times within CrashLoopMinutes is no
+	// longer restarted, 0 uses the defaults of 5 times in 10 minutes.
+	CrashLoopDeaths  int `json:"crash_loop_deaths,omitempty"`
+	CrashLoopMinutes int `json:"crash_loop_minutes,omitempty"`
+	// Health probes run every this many seconds, 0 uses the default of 30
+	// and a negative value only probes when the status is asked for.
+	HealthCheckSeconds int `json:"health_check_seconds,omitempty"`
+}
+
+// Feature plugins the daemon does not work without, EnabledPlugins and
+// DisabledPlugins only apply to the other plugins.
+var CorePlugins = []string{"jobs", "stats", "proxy"}
+
+// PluginEnabled tells whether the plugin registered under name should be
+// activated.
+func (self *MainConfig) PluginEnabled(name string) bool {
+	if containsFold(CorePlugins, name) {
+		return true
+	}
+	if containsFold(self.DisabledPlugins, name) {
+		return false
+	}
+	return len(self.EnabledPlugins) == 0 || containsFold(self.EnabledPlugins, name)
+}
+
+func containsFold(list []string, name string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, name) {
+			return true
+		}
+	}
+	return false
 }
 
 func Homedir() (string, error) {
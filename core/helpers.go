@@ -9,11 +9,20 @@ import (
 	"os"
 	"os/user"
 	"strconv"
+	"sync"
 	"time"
 )
 
 const letterBytes = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
 
+// Ports handed out by GetFreePort stay reserved for a while so two installs
+// running at the same time can't claim the same port before either of them
+// has bound it.
+const portReservation = 10 * time.Minute
+
+var claimedPorts = map[string]time.Time{}
+var portMutex = &sync.Mutex{}
+
 func GetUser(username string) (*user.User, error) {
 	if username == "" {
 		curUser, err := user.Current()
@@ -53,12 +62,24 @@ func GetFreePort() (string, error) {
 
 	log.Debugln("Attempting to get a free port")
 
+	portMutex.Lock()
+	defer portMutex.Unlock()
+
+	for port, claimed := range claimedPorts {
+		if time.Since(claimed) > portReservation {
+			delete(claimedPorts, port)
+		}
+	}
+
 	tries := 0
 	for tries < 10 {
 		port := strconv.Itoa(rand.Intn(50000) + 1024)
-		log.Debugf("Claimed port %d", port)
+		log.Debugf("Claimed port %s", port)
 
-		if PortFree(port) {
+		if _, ok := claimedPorts[port]; ok {
+			log.Debugf("Port %s is reserved by another install", port)
+		} else if PortFree(port) {
+			claimedPorts[port] = time.Now()
 			return port, nil
 		}
 
@@ -67,6 +88,13 @@ func GetFreePort() (string, error) {
 	return "", errors.New("Could not find free port.")
 }
 
+// ReleasePort gives up the reservation on a port claimed with GetFreePort.
+func ReleasePort(port string) {
+	portMutex.Lock()
+	delete(claimedPorts, port)
+	portMutex.Unlock()
+}
+
 func PortFree(port string) bool {
 	log.Debugf("Checking if port %s is free", port)
 
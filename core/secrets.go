@@ -0,0 +1,81 @@
+package core
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+// Option keys whose values never end up anywhere others can read them:
+// container labels, the audit log and the job log.
+var secretOptions = map[string]bool{
+	"password":           true,
+	"encrypted_password": true,
+	"salt":               true,
+	"api_key":            true,
+	"plex_claim":         true,
+	"deluge_password":    true,
+}
+
+var secretLock sync.RWMutex
+
+// IsSecretOption tells whether the value of an option has to be kept out of
+// labels and logs.
+func IsSecretOption(key string) bool {
+	secretLock.RLock()
+	defer secretLock.RUnlock()
+	return secretOptions[key]
+}
+
+// AddSecretOption marks an option as secret, for options manifests declare
+// with the secret type.
+func AddSecretOption(key string) {
+	secretLock.Lock()
+	defer secretLock.Unlock()
+	secretOptions[key] = true
+}
+
+// SecretOptionNames returns a copy of the secret option keys.
+func SecretOptionNames() map[string]bool {
+	secretLock.RLock()
+	defer secretLock.RUnlock()
+
+	res := map[string]bool{}
+	for key := range secretOptions {
+		res[key] = true
+	}
+	return res
+}
+
+// Redact returns value as plain JSON values with every secret option
+// replaced, at any depth.
+func Redact(value interface{}) interface{} {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+
+	var res interface{}
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil
+	}
+	return redactValue(res)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if IsSecretOption(key) {
+				v[key] = "[redacted]"
+			} else {
+				v[key] = redactValue(child)
+			}
+		}
+	case []interface{}:
+		for i, child := range v {
+			v[i] = redactValue(child)
+		}
+	}
+	return value
+}
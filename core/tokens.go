@@ -0,0 +1,88 @@
+package core
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AllMethods in a token's method list allows every RPC method.
+const AllMethods = "*"
+
+// Token is a named key and secret pair that may only call the RPC methods
+// in Methods. Entries are either full method names like "JobRPC.Get" or a
+// whole service like "Stats.*".
+type Token struct {
+	Name      string    `json:"name"`
+	Key       string    `json:"key"`
+	Secret    string    `json:"secret"`
+	Methods   []string  `json:"methods"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Allows tells whether the token may call method, for instance
+// "DelugeRPC.Install".
+func (self *Token) Allows(method string) bool {
+	for _, allowed := range self.Methods {
+		if allowed == AllMethods || allowed == method {
+			return true
+		}
+		if strings.HasSuffix(allowed, ".*") && strings.HasPrefix(method, strings.TrimSuffix(allowed, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewToken creates a token with a random key and secret.
+func NewToken(name string, methods []string) (*Token, error) {
+	key, err := randomHex(16)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := randomHex(32)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Token{Name: name, Key: key, Secret: secret, Methods: methods, CreatedAt: time.Now()}, nil
+}
+
+// AddToken adds token to the config, names have to be unique.
+func (self *MainConfig) AddToken(token *Token) error {
+	if self.FindToken(token.Name) != nil {
+		return fmt.Errorf("A token named '%s' already exists", token.Name)
+	}
+	self.Tokens = append(self.Tokens, token)
+	return nil
+}
+
+func (self *MainConfig) FindToken(name string) *Token {
+	for _, token := range self.Tokens {
+		if token.Name == name {
+			return token
+		}
+	}
+	return nil
+}
+
+func (self *MainConfig) RevokeToken(name string) error {
+	for i, token := range self.Tokens {
+		if token.Name == name {
+			self.Tokens = append(self.Tokens[:i], self.Tokens[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("Could not find a token named '%s'", name)
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,101 @@
+package engine
+
+import (
+	"fmt"
+	"github.com/bytesizedhosting/bcd/audit"
+	"github.com/bytesizedhosting/bcd/core"
+	"github.com/bytesizedhosting/bcd/jobs"
+	"net"
+	"net/rpc"
+	"strings"
+	"time"
+)
+
+// Methods starting with one of these only read state and are not audited.
+var readOnlyPrefixes = []string{"Get", "List", "Status", "Tail", "Show", "AuditLog", "Logs"}
+
+// Services that only have read-only methods.
+var readOnlyServices = map[string]bool{"Stats": true}
+
+func isReadOnly(method string) bool {
+	parts := strings.SplitN(method, ".", 2)
+	if len(parts) != 2 || readOnlyServices[parts[0]] {
+		return true
+	}
+	for _, prefix := range readOnlyPrefixes {
+		if strings.HasPrefix(parts[1], prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// caller is who an RPC request came from.
+type caller struct {
+	token   *core.Token
+	address string
+}
+
+func newCaller(token *core.Token, remoteAddr string) *caller {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	return &caller{token: token, address: host}
+}
+
+func (self *caller) allows(method string) bool {
+	return self.token.Allows(method)
+}
+
+// refuse records a call the token is not allowed to make and returns the
+// error message for it.
+func (self *caller) refuse(method string) string {
+	message := fmt.Sprintf("Method '%s' is not allowed for this token", method)
+	audit.Default.Write(&audit.Entry{Time: time.Now(), Token: self.token.Name, Address: self.address, Method: method, Error: message})
+	return message
+}
+
+// codec wraps the codec of a request so mutating calls end up in the audit
+// log.
+func (self *caller) codec(inner rpc.ServerCodec) rpc.ServerCodec {
+	return &auditCodec{ServerCodec: inner, caller: self}
+}
+
+type auditCodec struct {
+	rpc.ServerCodec
+	caller *caller
+	entry  *audit.Entry
+}
+
+func (self *auditCodec) ReadRequestHeader(r *rpc.Request) error {
+	err := self.ServerCodec.ReadRequestHeader(r)
+	if err != nil || isReadOnly(r.ServiceMethod) {
+		return err
+	}
+
+	self.entry = &audit.Entry{Time: time.Now(), Token: self.caller.token.Name, Address: self.caller.address, Method: r.ServiceMethod}
+	return nil
+}
+
+func (self *auditCodec) ReadRequestBody(x interface{}) error {
+	err := self.ServerCodec.ReadRequestBody(x)
+	if err == nil && self.entry != nil && x != nil {
+		self.entry.Args = core.Redact(x)
+	}
+	return err
+}
+
+func (self *auditCodec) WriteResponse(r *rpc.Response, x interface{}) error {
+	if self.entry != nil {
+		self.entry.DurationMs = int64(time.Since(self.entry.Time) / time.Millisecond)
+		self.entry.Success = r.Error == ""
+		self.entry.Error = r.Error
+		if job, ok := x.(*jobs.Job); ok {
+			self.entry.JobId = job.Id
+		}
+		audit.Default.Write(self.entry)
+		self.entry = nil
+	}
+	return self.ServerCodec.WriteResponse(r, x)
+}
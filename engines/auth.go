@@ -0,0 +1,141 @@
+package engine
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"github.com/bytesizedhosting/bcd/core"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Headers of a signed request. The signature is the hex encoded HMAC-SHA256,
+// keyed with the secret of the token, of the string built by StringToSign.
+const (
+	HeaderKey       = "X-Bcd-Key"
+	HeaderTimestamp = "X-Bcd-Timestamp"
+	HeaderNonce     = "X-Bcd-Nonce"
+	HeaderSignature = "X-Bcd-Signature"
+
+	// Requests with a timestamp further off than this are rejected.
+	MaxClockSkew = 5 * time.Minute
+)
+
+// Authenticator checks signed requests and remembers the nonces it has seen
+// for as long as their timestamp is acceptable.
+type Authenticator struct {
+	tokens         map[string]*core.Token
+	allowBasicAuth bool
+	nonces         map[string]time.Time
+	lock           sync.Mutex
+}
+
+// NewAuthenticator accepts the API key of the config, which may call every
+// method, and all tokens in it.
+func NewAuthenticator(config *core.MainConfig) *Authenticator {
+	auth := Authenticator{tokens: map[string]*core.Token{}, allowBasicAuth: config.AllowBasicAuth, nonces: map[string]time.Time{}}
+	for _, token := range config.Tokens {
+		auth.tokens[token.Key] = token
+	}
+	auth.tokens[config.ApiKey] = &core.Token{Name: "default", Key: config.ApiKey, Secret: config.ApiSecret, Methods: []string{core.AllMethods}}
+	return &auth
+}
+
+// StringToSign joins the parts of a request that are covered by the
+// signature, the body is included as its SHA256 hash.
+func StringToSign(method string, path string, body []byte, timestamp string, nonce string) string {
+	hash := sha256.Sum256(body)
+	return method + "\n" + path + "\n" + hex.EncodeToString(hash[:]) + "\n" + timestamp + "\n" + nonce
+}
+
+func Signature(secret string, stringToSign string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(stringToSign))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SignRequest adds the signature headers to a request, body has to be the
+// exact body that is sent.
+func SignRequest(r *http.Request, body []byte, apiKey string, apiSecret string, nonce string) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	r.Header.Set(HeaderKey, apiKey)
+	r.Header.Set(HeaderTimestamp, timestamp)
+	r.Header.Set(HeaderNonce, nonce)
+	r.Header.Set(HeaderSignature, Signature(apiSecret, StringToSign(r.Method, r.URL.RequestURI(), body, timestamp, nonce)))
+}
+
+// Authenticate returns the token the request is signed with, or an error
+// when it is not signed correctly. Basic auth with a key and secret is
+// accepted when it is allowed.
+func (self *Authenticator) Authenticate(r *http.Request, body []byte) (*core.Token, error) {
+	if r.Header.Get(HeaderSignature) == "" {
+		username, password, ok := r.BasicAuth()
+		if ok && self.allowBasicAuth {
+			token := self.tokens[username]
+			if token == nil || !equal(password, token.Secret) {
+				return nil, fmt.Errorf("Invalid basic auth credentials")
+			}
+			return token, nil
+		}
+		return nil, fmt.Errorf("Request is not signed")
+	}
+
+	token := self.tokens[r.Header.Get(HeaderKey)]
+	if token == nil {
+		return nil, fmt.Errorf("Unknown API key")
+	}
+
+	timestamp := r.Header.Get(HeaderTimestamp)
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid timestamp '%s'", timestamp)
+	}
+	signedAt := time.Unix(seconds, 0)
+	if skew := time.Since(signedAt); skew > MaxClockSkew || skew < -MaxClockSkew {
+		return nil, fmt.Errorf("Timestamp is too far off, check the clock of both servers")
+	}
+
+	nonce := r.Header.Get(HeaderNonce)
+	if nonce == "" {
+		return nil, fmt.Errorf("Request has no nonce")
+	}
+
+	expected := Signature(token.Secret, StringToSign(r.Method, r.URL.RequestURI(), body, timestamp, nonce))
+	if !hmac.Equal([]byte(expected), []byte(r.Header.Get(HeaderSignature))) {
+		return nil, fmt.Errorf("Invalid signature")
+	}
+
+	// Only remember nonces of valid requests, otherwise anybody could fill
+	// the cache.
+	if !self.useNonce(nonce, signedAt) {
+		return nil, fmt.Errorf("Nonce has already been used")
+	}
+	return token, nil
+}
+
+func (self *Authenticator) useNonce(nonce string, signedAt time.Time) bool {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	now := time.Now()
+	for n, expires := range self.nonces {
+		if now.After(expires) {
+			delete(self.nonces, n)
+		}
+	}
+
+	if _, used := self.nonces[nonce]; used {
+		return false
+	}
+	// After this the timestamp is rejected anyway.
+	self.nonces[nonce] = signedAt.Add(MaxClockSkew)
+	return true
+}
+
+func equal(a string, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
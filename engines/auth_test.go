@@ -0,0 +1,86 @@
+package engine
+
+import (
+	"github.com/bytesizedhosting/bcd/core"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func signedRequest(body string, nonce string) *http.Request {
+	r, _ := http.NewRequest("POST", "http://localhost:8112/rpc", strings.NewReader(body))
+	SignRequest(r, []byte(body), "key", "secret", nonce)
+	return r
+}
+
+func TestAuthenticate(t *testing.T) {
+	auth := NewAuthenticator(&core.MainConfig{ApiKey: "key", ApiSecret: "secret"})
+	body := `{"method": "CoreRPC.GetVersion", "params": [1], "id": 1}`
+
+	r := signedRequest(body, "one")
+	if _, err := auth.Authenticate(r, []byte(body)); err != nil {
+		t.Error("Valid request was rejected:", err)
+	}
+	if _, err := auth.Authenticate(r, []byte(body)); err == nil {
+		t.Error("Replayed request was accepted")
+	}
+
+	r = signedRequest(body, "two")
+	if _, err := auth.Authenticate(r, []byte(`{"method": "CoreRPC.ListApps"}`)); err == nil {
+		t.Error("Request with a changed body was accepted")
+	}
+
+	r = signedRequest(body, "three")
+	stale := strconv.FormatInt(time.Now().Add(-2*MaxClockSkew).Unix(), 10)
+	r.Header.Set(HeaderTimestamp, stale)
+	r.Header.Set(HeaderSignature, Signature("secret", StringToSign("POST", "/rpc", []byte(body), stale, "three")))
+	if _, err := auth.Authenticate(r, []byte(body)); err == nil {
+		t.Error("Request with a stale timestamp was accepted")
+	}
+
+	r, _ = http.NewRequest("POST", "http://localhost:8112/rpc", nil)
+	r.SetBasicAuth("key", "secret")
+	if _, err := auth.Authenticate(r, nil); err == nil {
+		t.Error("Basic auth was accepted while it is not allowed")
+	}
+	if _, err := NewAuthenticator(&core.MainConfig{ApiKey: "key", ApiSecret: "secret", AllowBasicAuth: true}).Authenticate(r, nil); err != nil {
+		t.Error("Basic auth was rejected while it is allowed:", err)
+	}
+}
+
+func TestTokenMethods(t *testing.T) {
+	token := &core.Token{Name: "monitoring", Key: "monitor", Secret: "s3cret", Methods: []string{"CoreRPC.*", "JobRPC.Get"}}
+	config := &core.MainConfig{ApiKey: "key", ApiSecret: "secret", Tokens: []*core.Token{token}}
+	auth := NewAuthenticator(config)
+
+	body := `[{"jsonrpc": "2.0", "method": "CoreRPC.GetVersion", "params": [1], "id": 1},
+		{"jsonrpc": "2.0", "method": "DelugeRPC.Uninstall", "params": [{}], "id": 2}]`
+	r, _ := http.NewRequest("POST", "http://localhost:8112/rpc", strings.NewReader(body))
+	SignRequest(r, []byte(body), "monitor", "s3cret", "token-nonce")
+
+	found, err := auth.Authenticate(r, []byte(body))
+	if err != nil || found.Name != "monitoring" {
+		t.Fatal("Token request was not authenticated:", err)
+	}
+	if !found.Allows("CoreRPC.ListApps") || !found.Allows("JobRPC.Get") || found.Allows("JobRPC.Cancel") || found.Allows("CoreRPCs.ListApps") {
+		t.Error("Token method list is not applied correctly")
+	}
+
+	w := httptest.NewRecorder()
+	engine.serveRPC(w, []byte(body), newCaller(found, r.RemoteAddr))
+	if !strings.Contains(w.Body.String(), `"code":-32001`) || strings.Count(w.Body.String(), `"code"`) != 1 {
+		t.Error("Expected only the uninstall to be refused, got:", w.Body.String())
+	}
+}
+
+func TestOversizedRequestIsRefused(t *testing.T) {
+	w := httptest.NewRecorder()
+	r, _ := http.NewRequest("POST", "http://localhost:8112/rpc", strings.NewReader(strings.Repeat("x", maxRequestSize+1)))
+	(&RpcEngine{}).ServeHTTP(w, r)
+	if w.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("Expected a too large request to be refused before authentication, got %d", w.Code)
+	}
+}
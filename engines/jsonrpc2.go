@@ -0,0 +1,187 @@
+package engine
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/rpc"
+	"strings"
+)
+
+// Error codes defined by the JSON-RPC 2.0 specification.
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+	CodeInternalError  = -32603
+	CodeServerError    = -32000
+	CodeForbidden      = -32001
+)
+
+type rpc2Request struct {
+	Version string           `json:"jsonrpc"`
+	Method  string           `json:"method"`
+	Params  *json.RawMessage `json:"params"`
+	Id      *json.RawMessage `json:"id"`
+}
+
+type rpc2Response struct {
+	Version string           `json:"jsonrpc"`
+	Result  interface{}      `json:"result,omitempty"`
+	Error   *rpc2Error       `json:"error,omitempty"`
+	Id      *json.RawMessage `json:"id"`
+}
+
+type rpc2Error struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+var errParams = errors.New("Invalid params")
+
+// isJsonRpc2 tells a 2.0 request or batch apart from a 1.0 request, the
+// latter never carries a jsonrpc member and is never an array.
+func isJsonRpc2(body []byte) bool {
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		return true
+	}
+
+	probe := struct {
+		Version string `json:"jsonrpc"`
+	}{}
+	json.Unmarshal(body, &probe)
+	return probe.Version == "2.0"
+}
+
+// serveJsonRpc2 handles a single JSON-RPC 2.0 request or a batch of them. It
+// returns nil when there is nothing to answer, which happens when all
+// requests were notifications.
+func (self *RpcEngine) serveJsonRpc2(body []byte, from *caller) interface{} {
+	body = bytes.TrimSpace(body)
+
+	if len(body) == 0 || body[0] != '[' {
+		var raw json.RawMessage
+		if err := json.Unmarshal(body, &raw); err != nil {
+			return errorResponse(nil, CodeParseError, "Parse error")
+		}
+		if res := self.serveOne(raw, from); res != nil {
+			return res
+		}
+		return nil
+	}
+
+	batch := []json.RawMessage{}
+	if err := json.Unmarshal(body, &batch); err != nil {
+		return errorResponse(nil, CodeParseError, "Parse error")
+	}
+	if len(batch) == 0 {
+		return errorResponse(nil, CodeInvalidRequest, "Invalid Request")
+	}
+
+	responses := []*rpc2Response{}
+	for _, raw := range batch {
+		if res := self.serveOne(raw, from); res != nil {
+			responses = append(responses, res)
+		}
+	}
+
+	if len(responses) == 0 {
+		return nil
+	}
+	return responses
+}
+
+func (self *RpcEngine) serveOne(raw json.RawMessage, from *caller) *rpc2Response {
+	req := rpc2Request{}
+	err := json.Unmarshal(raw, &req)
+	if err != nil || req.Version != "2.0" || req.Method == "" {
+		return errorResponse(req.Id, CodeInvalidRequest, "Invalid Request")
+	}
+
+	var res *rpc2Response
+	if !from.allows(req.Method) {
+		res = errorResponse(req.Id, CodeForbidden, from.refuse(req.Method))
+	} else if err := self.validate(req.Method, req.Params); err != nil {
+		res = errorResponse(req.Id, CodeInvalidParams, err.Error())
+		res.Error.Data = err
+	} else {
+		codec := &rpc2Codec{req: &req}
+		self.server.ServeRequest(from.codec(codec))
+		res = codec.res
+	}
+
+	// Notifications have no id and never get an answer, not even an error.
+	if req.Id == nil {
+		return nil
+	}
+	return res
+}
+
+func errorResponse(id *json.RawMessage, code int, message string) *rpc2Response {
+	return &rpc2Response{Version: "2.0", Error: &rpc2Error{Code: code, Message: message}, Id: id}
+}
+
+// rpc2Codec feeds one JSON-RPC 2.0 request to net/rpc and keeps the answer.
+type rpc2Codec struct {
+	req           *rpc2Request
+	res           *rpc2Response
+	invalidParams bool
+}
+
+func (self *rpc2Codec) ReadRequestHeader(r *rpc.Request) error {
+	r.ServiceMethod = self.req.Method
+	r.Seq = 0
+	return nil
+}
+
+// ReadRequestBody accepts params by name, an object, as well as the 1.0
+// style of an array holding a single object.
+func (self *rpc2Codec) ReadRequestBody(x interface{}) error {
+	if x == nil || self.req.Params == nil {
+		return nil
+	}
+
+	params := bytes.TrimSpace(*self.req.Params)
+	if len(params) > 0 && params[0] == '[' {
+		list := []json.RawMessage{}
+		if err := json.Unmarshal(params, &list); err != nil || len(list) > 1 {
+			self.invalidParams = true
+			return errParams
+		}
+		if len(list) == 0 {
+			return nil
+		}
+		params = list[0]
+	}
+
+	if err := json.Unmarshal(params, x); err != nil {
+		self.invalidParams = true
+		return errParams
+	}
+	return nil
+}
+
+func (self *rpc2Codec) WriteResponse(r *rpc.Response, x interface{}) error {
+	if r.Error == "" {
+		self.res = &rpc2Response{Version: "2.0", Result: x, Id: self.req.Id}
+		return nil
+	}
+
+	code := CodeServerError
+	switch {
+	case self.invalidParams:
+		code = CodeInvalidParams
+	case strings.HasPrefix(r.Error, "rpc: can't find"), strings.HasPrefix(r.Error, "rpc: service/method request ill-formed"):
+		code = CodeMethodNotFound
+	}
+
+	self.res = errorResponse(self.req.Id, code, r.Error)
+	return nil
+}
+
+func (self *rpc2Codec) Close() error {
+	return nil
+}
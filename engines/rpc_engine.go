@@ -2,15 +2,22 @@ package engine
 
 import (
 	"bytes"
+	"crypto/tls"
+	"encoding/json"
 	log "github.com/Sirupsen/logrus"
+	"github.com/bytesizedhosting/bcd/audit"
 	"github.com/bytesizedhosting/bcd/core"
+	"github.com/bytesizedhosting/bcd/events"
 	"github.com/bytesizedhosting/bcd/plugins"
+	"github.com/fsouza/go-dockerclient"
 	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
 	"net/rpc"
 	"net/rpc/jsonrpc"
+	"strings"
+	"time"
 )
 
 type HttpConn struct {
@@ -27,30 +34,61 @@ type SimplePluginRes struct {
 	Version int    `json:"version"`
 }
 
+type PluginProblem struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
 type PluginResponse struct {
-	Plugins []*SimplePluginRes `json:"plugins"`
+	Plugins  []*SimplePluginRes `json:"plugins"`
+	Disabled []*PluginProblem   `json:"disabled,omitempty"`
+	Failed   []*PluginProblem   `json:"failed,omitempty"`
 }
 type ManifestResponse struct {
 	Manifests []*plugins.Manifest `json:"manifests"`
 }
+type AppsResponse struct {
+	Apps []*plugins.App `json:"apps"`
+}
+type UpdatesArgs struct {
+	// Check again right away instead of returning the last results.
+	Check bool `json:"check"`
+}
+type UpdatesResponse struct {
+	Updates   []*plugins.ImageUpdate `json:"updates"`
+	CheckedAt time.Time              `json:"checked_at"`
+}
+type AuditResponse struct {
+	Entries []*audit.Entry `json:"entries"`
+}
+
+// EventsMethod is the name tokens need in their method list to open the
+// event stream.
+const EventsMethod = "Events.Stream"
 
 type RpcEngine struct {
-	server  *rpc.Server
-	plugins []*plugins.Plugin
-	port    string
-	config  *core.MainConfig
+	server       *rpc.Server
+	plugins      []*plugins.Plugin
+	port         string
+	config       *core.MainConfig
+	dockerClient *docker.Client
+	auth         *Authenticator
+	disabled     []*PluginProblem
+	failed       []*PluginProblem
+	updates      *plugins.UpdateChecker
 }
 
 func (self *RpcEngine) Server() *rpc.Server {
 	return self.server
 }
 
-func NewRpcEngine(config *core.MainConfig) *RpcEngine {
-	engine := RpcEngine{}
+func NewRpcEngine(config *core.MainConfig, dockerClient *docker.Client) *RpcEngine {
+	engine := RpcEngine{dockerClient: dockerClient}
 	engine.server = rpc.NewServer()
 	engine.server.HandleHTTP(rpc.DefaultRPCPath, rpc.DefaultDebugPath)
 	engine.port = config.Port
 	engine.config = config
+	engine.auth = NewAuthenticator(config)
 	engine.server.Register(&CoreRPC{&engine})
 	return &engine
 }
@@ -65,38 +103,104 @@ func (self *RpcEngine) Start() {
 		log.Fatal("Could not bind on port:", e)
 	}
 
-	http.Serve(l, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		username, password, _ := r.BasicAuth()
+	if self.config.TLSCert != "" {
+		tlsConfig, err := core.TLSConfig(self.config.TLSCert, self.config.TLSKey)
+		if err != nil {
+			log.Fatal("Could not load the TLS certificate:", err)
+		}
+		l = tls.NewListener(l, tlsConfig)
+		log.Infoln("Serving RPC over TLS")
+	} else {
+		log.Warnln("No TLS certificate configured, RPC traffic is not encrypted. Run 'bcd tls' to generate one.")
+	}
+
+	http.Serve(l, self)
+}
+
+// Requests are read before they are authenticated, so their size is
+// limited. Installs send a few kilobytes of options at most.
+const maxRequestSize = 1 << 20
 
-		log.Debugf("received username %s and password %s", username, password)
-		if username != self.config.ApiKey || password != self.config.ApiSecret {
-			log.Debug("Wrong authentication, not processing")
-			log.Debugf("Expected %s and %s", self.config.ApiKey, self.config.ApiSecret)
-			w.WriteHeader(401)
+func (self *RpcEngine) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxRequestSize)
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("Could not read request: %v", err)
+		if len(body) >= maxRequestSize {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+		} else {
+			w.WriteHeader(400)
+		}
+		return
+	}
+
+	token, err := self.auth.Authenticate(r, body)
+	if err != nil {
+		log.Debugln("Wrong authentication, not processing:", err)
+		w.WriteHeader(401)
+		return
+	}
+
+	if r.URL.Path == "/rpc" {
+		log.Debugf("Received call to RPC interface with token '%s'", token.Name)
+		self.serveRPC(w, body, newCaller(token, r.RemoteAddr))
+	}
+
+	if r.URL.Path == "/events" {
+		if !token.Allows(EventsMethod) {
+			log.Debugf("Token '%s' may not open the event stream", token.Name)
+			w.WriteHeader(403)
 			return
 		}
+		log.Debug("Received connection to the event stream")
+		events.Serve(events.Default, w, r)
+	}
+}
+
+// serveRPC answers JSON-RPC 2.0 requests and batches, anything else is
+// handled as JSON-RPC 1.0 for older clients. Methods that are not allowed
+// are answered with an error without being called.
+func (self *RpcEngine) serveRPC(w http.ResponseWriter, body []byte, from *caller) {
+	w.Header().Set("Content-type", "application/json")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
 
-		if r.URL.Path == "/rpc" {
-			log.Debug("Received call to RPC interface")
-			body, err := ioutil.ReadAll(r.Body)
-			if err == nil {
-				log.Debugf("HTTP Body: %s", body)
-			}
-			// Place the data back in the buffer so we can process it normally
-			r.Body = ioutil.NopCloser(bytes.NewBuffer(body))
-
-			serverCodec := jsonrpc.NewServerCodec(&HttpConn{in: r.Body, out: w})
-			w.Header().Set("Content-type", "application/json")
-			w.Header().Set("Access-Control-Allow-Origin", "*")
-			w.WriteHeader(200)
-			err = self.server.ServeRequest(serverCodec)
-			if err != nil {
-				log.Printf("Error while serving JSON request: %v", err)
-				return
-			}
+	if isJsonRpc2(body) {
+		res := self.serveJsonRpc2(body, from)
+		if res == nil {
+			w.WriteHeader(204)
+			return
+		}
+		w.WriteHeader(200)
+		err := json.NewEncoder(w).Encode(res)
+		if err != nil {
+			log.Printf("Error while writing JSON response: %v", err)
 		}
+		return
+	}
+
+	req := struct {
+		Method string           `json:"method"`
+		Params *json.RawMessage `json:"params"`
+		Id     *json.RawMessage `json:"id"`
+	}{}
+	json.Unmarshal(body, &req)
+	if !from.allows(req.Method) {
+		w.WriteHeader(200)
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": req.Id, "result": nil, "error": from.refuse(req.Method)})
+		return
+	}
+	if err := self.validate(req.Method, req.Params); err != nil {
+		w.WriteHeader(200)
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": req.Id, "result": nil, "error": err.Error()})
+		return
+	}
 
-	}))
+	serverCodec := jsonrpc.NewServerCodec(&HttpConn{in: bytes.NewBuffer(body), out: w})
+	w.WriteHeader(200)
+	err := self.server.ServeRequest(from.codec(serverCodec))
+	if err != nil {
+		log.Printf("Error while serving JSON request: %v", err)
+	}
 }
 
 func (self *RpcEngine) Activate(p plugins.Plugin) {
@@ -111,3 +215,103 @@ func (self *RpcEngine) Activate(p plugins.Plugin) {
 	// Add enabled plugins
 	self.plugins = append(self.plugins, &p)
 }
+
+// ActivateRegistered activates every registered plugin the config enables.
+// Plugins that are disabled or fail to load are remembered for GetPlugins.
+func (self *RpcEngine) ActivateRegistered() {
+	for _, name := range plugins.Registered() {
+		if !self.config.PluginEnabled(name) {
+			log.Infof("Plugin %s is disabled in the config", name)
+			self.disabled = append(self.disabled, &PluginProblem{Name: name, Reason: "Disabled in the config"})
+			continue
+		}
+
+		p, err := plugins.Create(name, self.dockerClient)
+		if err != nil {
+			log.Infof("Could not enable plugin %s: %s", name, err)
+			self.failed = append(self.failed, &PluginProblem{Name: name, Reason: err.Error()})
+			continue
+		}
+		self.Activate(p)
+	}
+}
+
+// StartUpdateChecker checks the images of the installed apps for updates in
+// the background, unless the config turns that off.
+func (self *RpcEngine) StartUpdateChecker() {
+	if self.config.UpdateCheckHours < 0 {
+		log.Infoln("Image update checks are disabled in the config")
+		return
+	}
+
+	interval := plugins.DefaultUpdateInterval
+	if self.config.UpdateCheckHours > 0 {
+		interval = time.Duration(self.config.UpdateCheckHours) * time.Hour
+	}
+
+	registry := plugins.NewRegistryClient(self.config.RegistryUrl)
+	self.updates = plugins.NewUpdateChecker(self.dockerClient, registry, self.plugin)
+	go self.updates.Run(interval)
+}
+
+// StartHealthMonitor runs the health probes of the apps in the background,
+// unless the config turns that off.
+func (self *RpcEngine) StartHealthMonitor() {
+	if self.config.HealthCheckSeconds < 0 {
+		log.Infoln("Periodic health probes are disabled in the config")
+		return
+	}
+
+	interval := plugins.DefaultHealthInterval
+	if self.config.HealthCheckSeconds > 0 {
+		interval = time.Duration(self.config.HealthCheckSeconds) * time.Second
+	}
+	go plugins.Probes.Run(self.dockerClient, self.plugin, interval)
+}
+
+// validate checks the options of a plugin call against the method options
+// in its manifest, before the call is made.
+func (self *RpcEngine) validate(method string, params *json.RawMessage) error {
+	parts := strings.SplitN(method, ".", 2)
+	if len(parts) != 2 || params == nil {
+		return nil
+	}
+
+	var manifest *plugins.Manifest
+	for _, plugin := range self.plugins {
+		m := (*plugin).GetManifest()
+		if m != nil && m.RpcName == parts[0] {
+			manifest = m
+			break
+		}
+	}
+	if manifest == nil {
+		return nil
+	}
+
+	// Options are sent as an object, or as an array holding one object.
+	raw := bytes.TrimSpace(*params)
+	if len(raw) > 0 && raw[0] == '[' {
+		list := []json.RawMessage{}
+		if json.Unmarshal(raw, &list) != nil || len(list) != 1 {
+			return nil
+		}
+		raw = list[0]
+	}
+
+	options := map[string]interface{}{}
+	if json.Unmarshal(raw, &options) != nil {
+		return nil
+	}
+	return manifest.Validate(parts[1], options)
+}
+
+func (self *RpcEngine) plugin(name string) plugins.Plugin {
+	for _, plugin := range self.plugins {
+		p := *plugin
+		if p.GetName() == name {
+			return p
+		}
+	}
+	return nil
+}
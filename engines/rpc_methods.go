@@ -1,7 +1,10 @@
 package engine
 
 import (
+	"fmt"
+	"github.com/bytesizedhosting/bcd/audit"
 	"github.com/bytesizedhosting/bcd/core"
+	"github.com/bytesizedhosting/bcd/plugins"
 )
 
 type CoreRPC struct {
@@ -29,6 +32,57 @@ func (self *CoreRPC) GetPlugins(nothing *PluginResponse, res *PluginResponse) er
 		p := *plugin
 		res.Plugins = append(res.Plugins, &SimplePluginRes{Name: p.GetName(), Version: p.GetVersion()})
 	}
+	res.Disabled = self.engine.disabled
+	res.Failed = self.engine.failed
 
 	return nil
 }
+
+// ListApps rebuilds the list of installed apps from the labels on the Docker
+// containers. Containers that look like ours but can't be matched to a plugin
+// are returned with a problem set.
+func (self *CoreRPC) ListApps(_ int, res *AppsResponse) error {
+	apps, err := plugins.FindApps(self.engine.dockerClient)
+	if err != nil {
+		return err
+	}
+
+	for _, app := range apps {
+		if app.Problem == "" && self.engine.plugin(app.Plugin) == nil {
+			app.Problem = fmt.Sprintf("No plugin named '%s' is enabled", app.Plugin)
+		}
+	}
+
+	res.Apps = apps
+	return nil
+}
+
+// Updates returns which apps run an older image than the registry serves for
+// their tag, as found by the background checker.
+func (self *CoreRPC) Updates(args *UpdatesArgs, res *UpdatesResponse) error {
+	checker := self.engine.updates
+	if checker == nil {
+		return fmt.Errorf("Image update checks are disabled")
+	}
+
+	if args.Check {
+		_, err := checker.Check()
+		if err != nil {
+			return err
+		}
+	}
+
+	res.Updates, res.CheckedAt = checker.Updates()
+	return nil
+}
+
+// AuditLog returns the recorded calls to mutating methods, oldest first.
+func (self *CoreRPC) AuditLog(filter *audit.Filter, res *AuditResponse) error {
+	entries, err := audit.Default.Query(*filter)
+	if err != nil {
+		return err
+	}
+
+	res.Entries = entries
+	return nil
+}
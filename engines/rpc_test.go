@@ -1,11 +1,14 @@
 package engine
 
 import (
+	"encoding/json"
 	"github.com/fsouza/go-dockerclient"
 	"github.com/bytesizedhosting/bcd/core"
 	"github.com/bytesizedhosting/bcd/plugins/deluge"
 	"log"
+	"net/http/httptest"
 	"net/rpc"
+	"strings"
 	"testing"
 )
 
@@ -50,3 +53,85 @@ func TestGetManifests(t *testing.T) {
 		t.Error("Manifest has no name set", b.Manifests[0])
 	}
 }
+
+var allowAll = &caller{token: &core.Token{Name: "test", Methods: []string{core.AllMethods}}}
+
+func TestJsonRpc2Batch(t *testing.T) {
+	body := `[
+		{"jsonrpc": "2.0", "method": "CoreRPC.GetVersion", "params": [1], "id": 1},
+		{"jsonrpc": "2.0", "method": "CoreRPC.GetVersion", "params": 1},
+		{"jsonrpc": "2.0", "method": "CoreRPC.Missing", "id": "two"},
+		{"jsonrpc": "2.0", "method": "CoreRPC.GetVersion", "params": "one", "id": 3},
+		{"method": "CoreRPC.GetVersion", "id": 4}
+	]`
+	w := httptest.NewRecorder()
+	engine.serveRPC(w, []byte(body), allowAll)
+
+	res := []struct {
+		Result string
+		Error  *rpc2Error
+		Id     interface{}
+	}{}
+	err := json.Unmarshal(w.Body.Bytes(), &res)
+	if err != nil {
+		t.Fatal("Could not decode batch response:", err, w.Body.String())
+	}
+	if len(res) != 4 {
+		t.Fatal("Expected four responses, the notification gets none:", w.Body.String())
+	}
+
+	if res[0].Result != core.VerString || res[0].Id != float64(1) {
+		t.Error("Expected the version for id 1, got:", res[0])
+	}
+	expected := []int{CodeMethodNotFound, CodeInvalidParams, CodeInvalidRequest}
+	for i, code := range expected {
+		if res[i+1].Error == nil || res[i+1].Error.Code != code {
+			t.Errorf("Expected error code %d, got: %v", code, res[i+1].Error)
+		}
+	}
+}
+
+func TestJsonRpc1StillWorks(t *testing.T) {
+	w := httptest.NewRecorder()
+	engine.serveRPC(w, []byte(`{"method": "CoreRPC.GetVersion", "params": [1], "id": 7}`), allowAll)
+
+	if !strings.Contains(w.Body.String(), core.VerString) || strings.Contains(w.Body.String(), "jsonrpc") {
+		t.Error("Expected a JSON-RPC 1.0 answer, got:", w.Body.String())
+	}
+}
+
+func TestInstallOptionsAreValidated(t *testing.T) {
+	w := httptest.NewRecorder()
+	engine.serveRPC(w, []byte(`{"jsonrpc": "2.0", "method": "DelugeRPC.Install", "params": {"web_port": "http", "colour": "red"}, "id": 1}`), allowAll)
+
+	res := struct {
+		Error *struct {
+			Code int
+			Data struct {
+				Fields []struct{ Field string }
+			}
+		}
+	}{}
+	json.Unmarshal(w.Body.Bytes(), &res)
+	if res.Error == nil || res.Error.Code != CodeInvalidParams || len(res.Error.Data.Fields) != 2 {
+		t.Error("Expected both fields to be reported as invalid params, got:", w.Body.String())
+	}
+
+	w = httptest.NewRecorder()
+	engine.serveRPC(w, []byte(`{"method": "DelugeRPC.Install", "params": [{"web_port": "0"}], "id": 2}`), allowAll)
+	if !strings.Contains(w.Body.String(), "web_port must be a port") {
+		t.Error("Expected a JSON-RPC 1.0 validation error, got:", w.Body.String())
+	}
+}
+
+func TestAuditRedactsSecrets(t *testing.T) {
+	if !isReadOnly("CoreRPC.ListApps") || !isReadOnly("Stats.Load") || isReadOnly("DelugeRPC.Uninstall") {
+		t.Error("Read-only methods are not detected correctly")
+	}
+
+	args := map[string]interface{}{"password": "hunter2", "nested": map[string]interface{}{"api_key": "abc", "port": "80"}}
+	data, _ := json.Marshal(core.Redact(args))
+	if strings.Contains(string(data), "hunter2") || strings.Contains(string(data), "abc") || !strings.Contains(string(data), "80") {
+		t.Error("Arguments were not redacted correctly:", string(data))
+	}
+}
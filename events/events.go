@@ -0,0 +1,186 @@
+package events
+
+import (
+	log "github.com/Sirupsen/logrus"
+	"sync"
+	"time"
+)
+
+// Topics clients can subscribe to.
+const (
+	TopicJobs       = "jobs"
+	TopicContainers = "containers"
+	TopicProxy      = "proxy"
+	TopicStats      = "stats"
+	TopicUpdates    = "updates"
+
+	// Used for events about the stream itself, these are always delivered.
+	TopicEvents = "events"
+)
+
+const (
+	// How many events are kept around for clients that resume.
+	DefaultHistory = 1000
+
+	subscriberBuffer = 256
+)
+
+type Event struct {
+	Seq   uint64      `json:"seq"`
+	Topic string      `json:"topic"`
+	Type  string      `json:"type"`
+	Time  time.Time   `json:"time"`
+	Data  interface{} `json:"data,omitempty"`
+}
+
+// GapData is sent when a client resumes from a sequence number that is no
+// longer in the history, events between From and Oldest were lost.
+type GapData struct {
+	From   uint64 `json:"from"`
+	Oldest uint64 `json:"oldest"`
+}
+
+// Bus hands out every published event to the interested subscribers and
+// keeps a short history so clients can pick up where they left off.
+type Bus struct {
+	lock        sync.Mutex
+	seq         uint64
+	history     []Event
+	size        int
+	subscribers map[*Subscription]bool
+}
+
+type Subscription struct {
+	Events chan Event
+	topics map[string]bool
+	bus    *Bus
+}
+
+var Default = NewBus(DefaultHistory)
+
+func NewBus(size int) *Bus {
+	return &Bus{size: size, subscribers: map[*Subscription]bool{}}
+}
+
+// Publish sends an event on the default bus.
+func Publish(topic string, eventType string, data interface{}) {
+	Default.Publish(topic, eventType, data)
+}
+
+// HasSubscribers tells whether anybody listens to topic on the default bus,
+// publishers of expensive events can skip the work otherwise.
+func HasSubscribers(topic string) bool {
+	return Default.HasSubscribers(topic)
+}
+
+func (self *Bus) Publish(topic string, eventType string, data interface{}) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.seq++
+	event := Event{Seq: self.seq, Topic: topic, Type: eventType, Time: time.Now(), Data: data}
+
+	self.history = append(self.history, event)
+	if len(self.history) > self.size {
+		self.history = append([]Event{}, self.history[len(self.history)-self.size:]...)
+	}
+
+	for sub := range self.subscribers {
+		if !sub.wants(topic) {
+			continue
+		}
+		select {
+		case sub.Events <- event:
+		default:
+			// Never let a slow client hold up the daemon, it can resume
+			// with the last sequence number it saw.
+			log.Infoln("Event subscriber can't keep up, dropping it")
+			self.remove(sub)
+		}
+	}
+}
+
+func (self *Bus) HasSubscribers(topic string) bool {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for sub := range self.subscribers {
+		if sub.wants(topic) {
+			return true
+		}
+	}
+	return false
+}
+
+// Subscribe listens to topics, all topics when empty. Events in the history
+// newer than since are queued on the subscription first.
+func (self *Bus) Subscribe(topics []string, since uint64) *Subscription {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	sub := &Subscription{Events: make(chan Event, subscriberBuffer+len(self.history)), bus: self}
+	sub.setTopics(topics)
+
+	if since > 0 && len(self.history) > 0 && self.history[0].Seq > since+1 {
+		gap := GapData{From: since, Oldest: self.history[0].Seq}
+		sub.Events <- Event{Topic: TopicEvents, Type: "gap", Time: time.Now(), Data: gap}
+	}
+
+	if since > 0 {
+		for _, event := range self.history {
+			if event.Seq > since && sub.wants(event.Topic) {
+				sub.Events <- event
+			}
+		}
+	}
+
+	self.subscribers[sub] = true
+	return sub
+}
+
+func (self *Bus) Unsubscribe(sub *Subscription) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.remove(sub)
+}
+
+func (self *Bus) remove(sub *Subscription) {
+	if self.subscribers[sub] {
+		delete(self.subscribers, sub)
+		close(sub.Events)
+	}
+}
+
+// SetTopics replaces the topics of the subscription, empty means all topics.
+func (self *Subscription) SetTopics(topics []string) {
+	self.bus.lock.Lock()
+	defer self.bus.lock.Unlock()
+
+	self.setTopics(topics)
+}
+
+// Topics returns the topics the subscription listens to.
+func (self *Subscription) Topics() []string {
+	self.bus.lock.Lock()
+	defer self.bus.lock.Unlock()
+
+	topics := []string{}
+	for topic := range self.topics {
+		topics = append(topics, topic)
+	}
+	return topics
+}
+
+func (self *Subscription) setTopics(topics []string) {
+	self.topics = map[string]bool{}
+	for _, topic := range topics {
+		if topic != "" {
+			self.topics[topic] = true
+		}
+	}
+}
+
+func (self *Subscription) wants(topic string) bool {
+	return topic == TopicEvents || len(self.topics) == 0 || self.topics[topic]
+}
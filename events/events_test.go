@@ -0,0 +1,86 @@
+package events
+
+import (
+	"github.com/gorilla/websocket"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSubscribeResumes(t *testing.T) {
+	bus := NewBus(3)
+	for i := 0; i < 5; i++ {
+		bus.Publish(TopicJobs, "job.updated", i)
+	}
+	bus.Publish(TopicProxy, "proxy.added", nil)
+
+	// Sequence 1 fell out of the history, so a gap is reported first.
+	sub := bus.Subscribe([]string{TopicJobs}, 1)
+	gap := <-sub.Events
+	if gap.Type != "gap" {
+		t.Error("Expected a gap event, got:", gap)
+	}
+	for _, seq := range []uint64{4, 5} {
+		event := <-sub.Events
+		if event.Seq != seq {
+			t.Errorf("Expected event %d from the history, got: %v", seq, event)
+		}
+	}
+
+	bus.Publish(TopicStats, "stats.sample", nil)
+	bus.Publish(TopicJobs, "job.updated", 6)
+	if event := <-sub.Events; event.Seq != 8 {
+		t.Error("Expected only the new jobs event, got:", event)
+	}
+}
+
+func TestWebSocketStream(t *testing.T) {
+	bus := NewBus(DefaultHistory)
+	bus.Publish(TopicJobs, "job.updated", "missed")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		Serve(bus, w, r)
+	}))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/?topics=jobs&since=0", nil)
+	if err != nil {
+		t.Fatal("Handshake failed:", err)
+	}
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	for !bus.HasSubscribers(TopicJobs) {
+		time.Sleep(time.Millisecond)
+	}
+	bus.Publish(TopicProxy, "proxy.added", nil)
+	bus.Publish(TopicJobs, "job.updated", "hello")
+
+	event := Event{}
+	err = conn.ReadJSON(&event)
+	if err != nil || event.Seq != 3 || event.Data != "hello" {
+		t.Error("Expected the jobs event, got:", event, err)
+	}
+
+	// Subscribe to another topic.
+	conn.WriteJSON(ClientMessage{Topics: []string{TopicProxy}})
+	err = conn.ReadJSON(&event)
+	if err != nil || event.Type != "subscribed" {
+		t.Error("Expected the subscription to be confirmed, got:", event, err)
+	}
+	bus.Publish(TopicJobs, "job.updated", "ignored")
+	bus.Publish(TopicProxy, "proxy.added", "proxied")
+	err = conn.ReadJSON(&event)
+	if err != nil || event.Data != "proxied" {
+		t.Error("Expected the proxy event, got:", event, err)
+	}
+
+	// The server answers a close with a close.
+	conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	_, _, err = conn.ReadMessage()
+	if !websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+		t.Error("Expected the close to be answered, got:", err)
+	}
+}
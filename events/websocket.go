@@ -0,0 +1,119 @@
+package events
+
+import (
+	"encoding/json"
+	log "github.com/Sirupsen/logrus"
+	"github.com/gorilla/websocket"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	maxMessageSize = 64 * 1024
+	pingInterval   = 30 * time.Second
+	writeTimeout   = 10 * time.Second
+)
+
+var upgrader = websocket.Upgrader{
+	// Requests are authenticated before they get here, like the RPC
+	// interface the stream can be used from any origin.
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+// ClientMessage is what clients send to change their subscription, for
+// instance {"topics": ["jobs", "containers"]}. No topics means all topics.
+type ClientMessage struct {
+	Topics []string `json:"topics"`
+}
+
+// wsConn allows a single writer at a time, as the WebSocket connection
+// requires.
+type wsConn struct {
+	conn *websocket.Conn
+	lock sync.Mutex
+}
+
+// Serve upgrades the request to a WebSocket and streams events from bus to
+// it. The topics and since query parameters set the initial subscription,
+// since being the last sequence number the client has seen.
+func Serve(bus *Bus, w http.ResponseWriter, r *http.Request) {
+	// Upgrade answers failed handshakes itself.
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Debugln("Could not upgrade to a WebSocket:", err)
+		return
+	}
+	defer conn.Close()
+	conn.SetReadLimit(maxMessageSize)
+	ws := &wsConn{conn: conn}
+
+	topics := []string{}
+	if t := r.URL.Query().Get("topics"); t != "" {
+		topics = strings.Split(t, ",")
+	}
+	since, _ := strconv.ParseUint(r.URL.Query().Get("since"), 10, 64)
+
+	sub := bus.Subscribe(topics, since)
+	defer bus.Unsubscribe(sub)
+
+	closed := make(chan bool)
+	go func() {
+		defer close(closed)
+		for {
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+					log.Debugln("Closing event stream:", err)
+				}
+				return
+			}
+
+			req := ClientMessage{}
+			err = json.Unmarshal(msg, &req)
+			if err != nil {
+				log.Debugln("Ignoring invalid message on the event stream:", err)
+				continue
+			}
+			sub.SetTopics(req.Topics)
+			ws.writeJSON(Event{Topic: TopicEvents, Type: "subscribed", Time: time.Now(), Data: sub.Topics()})
+		}
+	}()
+
+	ping := time.NewTicker(pingInterval)
+	defer ping.Stop()
+
+	for {
+		select {
+		case event, ok := <-sub.Events:
+			if !ok {
+				ws.writeClose(websocket.ClosePolicyViolation, "Client is too slow, resume from the last sequence number")
+				return
+			}
+			err = ws.writeJSON(event)
+		case <-ping.C:
+			err = conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeTimeout))
+		case <-closed:
+			return
+		}
+
+		if err != nil {
+			log.Debugln("Could not write to the event stream:", err)
+			return
+		}
+	}
+}
+
+func (self *wsConn) writeJSON(v interface{}) error {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	return self.conn.WriteJSON(v)
+}
+
+func (self *wsConn) writeClose(code int, reason string) error {
+	return self.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(writeTimeout))
+}
@@ -0,0 +1,73 @@
+package jobs
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	log "github.com/Sirupsen/logrus"
+	"net/http"
+	"time"
+)
+
+const (
+	// Header holding the hex encoded HMAC-SHA256 of the request body, keyed
+	// with the API secret.
+	SignatureHeader = "X-Bcd-Signature"
+
+	callbackAttempts = 3
+)
+
+// CallbackSecret signs the callbacks, it is set to the API secret on start.
+var CallbackSecret string
+
+var callbackClient = &http.Client{Timeout: 10 * time.Second}
+
+// Sign returns the signature for body as sent in the SignatureHeader.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// notify posts the finished job to its callback url, failed attempts are
+// retried a couple of times before giving up.
+func notify(job Job) {
+	body, err := json.Marshal(job)
+	if err != nil {
+		log.Warnf("Could not encode job %s for its callback: %s", job.Id, err)
+		return
+	}
+
+	for attempt := 1; attempt <= callbackAttempts; attempt++ {
+		err = post(job.CallbackUrl, body)
+		if err == nil {
+			log.Debugf("Callback for job %s delivered to %s", job.Id, job.CallbackUrl)
+			return
+		}
+		log.Infof("Callback for job %s failed (attempt %d of %d): %s", job.Id, attempt, callbackAttempts, err)
+		time.Sleep(time.Duration(attempt) * 5 * time.Second)
+	}
+}
+
+func post(url string, body []byte) error {
+	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(CallbackSecret, body))
+
+	res, err := callbackClient.Do(req)
+	if err != nil {
+		return err
+	}
+	res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("Callback returned status %d", res.StatusCode)
+	}
+	return nil
+}
@@ -1,9 +1,18 @@
 package jobs
 
 import (
+	"bufio"
+	"context"
+	"encoding/json"
 	"fmt"
+	log "github.com/Sirupsen/logrus"
 	"github.com/bytesizedhosting/bcd/core"
+	"github.com/bytesizedhosting/bcd/events"
+	"os"
+	"path"
+	"sort"
 	"sync"
+	"time"
 )
 
 const (
@@ -12,17 +21,357 @@ const (
 	FINISHED = iota
 )
 
+const (
+	DefaultRetention = 7 * 24 * time.Hour
+	logFileName      = "jobs.log"
+
+	// Only the most recent log lines of a job are kept.
+	MaxLogLines = 500
+
+	// The job log is compacted once it grows past this size and has at
+	// least doubled since the last compaction.
+	maxLogFileSize = 8 * 1024 * 1024
+)
+
+// Names of the stages an installation or upgrade goes through.
+const (
+	StageFolders   = "Ensuring folders"
+	StageTemplates = "Writing templates"
+	StagePull      = "Pulling image"
+	StageCreate    = "Creating container"
+	StageStart     = "Starting container"
+	StageReplace   = "Replacing container"
+	StageHealth    = "Waiting for the app to become healthy"
+	StageRollback  = "Rolling back"
+)
+
 type Job struct {
 	Id          string      `json:"job_id,omitempty"`
+	Plugin      string      `json:"plugin,omitempty"`
 	Status      int         `json:"status,omitempty"`
 	Options     interface{} `json:"options,omitempty"`
-	Error       error       `json:"error,omitempty"`
-	ErrorString string      `json:"error_message, omitempty"`
+	Error       error       `json:"-"`
+	ErrorString string      `json:"error_message,omitempty"`
+	CreatedAt   time.Time   `json:"created_at"`
+	StartedAt   *time.Time  `json:"started_at,omitempty"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+	Stages      []Stage     `json:"stages,omitempty"`
+	Log         []LogLine   `json:"log,omitempty"`
+	LogCursor   int         `json:"log_cursor"`
+	CallbackUrl string      `json:"callback_url,omitempty"`
+	RolledBack  []Undone    `json:"rolled_back,omitempty"`
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	rollback []rollbackStep
+	failing  bool
+}
+
+type rollbackStep struct {
+	name string
+	undo func() error
+}
+
+// Undone is a step of a failed job that was rolled back, Error is set when
+// undoing it failed.
+type Undone struct {
+	Step  string `json:"step"`
+	Error string `json:"error,omitempty"`
+}
+
+type Stage struct {
+	Name       string     `json:"name"`
+	StartedAt  time.Time  `json:"started_at"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	Current    int64      `json:"current,omitempty"`
+	Total      int64      `json:"total,omitempty"`
+}
+
+// ListFilter selects jobs in Storage.List, empty fields match every job.
+type ListFilter struct {
+	Plugin string    `json:"plugin,omitempty"`
+	Status int       `json:"status,omitempty"`
+	Since  time.Time `json:"since,omitempty"`
+	Until  time.Time `json:"until,omitempty"`
+}
+
+func (self *ListFilter) Match(job *Job) bool {
+	if self.Plugin != "" && job.Plugin != self.Plugin {
+		return false
+	}
+	if self.Status != 0 && job.Status != self.Status {
+		return false
+	}
+	if !self.Since.IsZero() && job.CreatedAt.Before(self.Since) {
+		return false
+	}
+	if !self.Until.IsZero() && job.CreatedAt.After(self.Until) {
+		return false
+	}
+	return true
+}
+
+// JobEvent is published on the jobs topic whenever a job changes state.
+type JobEvent struct {
+	Id          string `json:"job_id"`
+	Plugin      string `json:"plugin,omitempty"`
+	Status      int    `json:"status"`
+	Stage       string `json:"stage,omitempty"`
+	ErrorString string `json:"error_message,omitempty"`
+}
+
+type LogLine struct {
+	Cursor int       `json:"cursor"`
+	Time   time.Time `json:"time"`
+	Line   string    `json:"line"`
+}
+
+func (self *Job) Finished() bool {
+	return self.Status == FINISHED || self.Status == FAILED
+}
+
+// Start marks the job as running, it is called once the job leaves the
+// queue.
+func (self *Job) Start() {
+	if self == nil {
+		return
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	now := time.Now()
+	self.Status = BUSY
+	self.StartedAt = &now
+	self.addLine(now, "Job started")
+
+	Storage.set(self, true)
+}
+
+// Succeed finishes the job, options are the final options the app was
+// installed with.
+func (self *Job) Succeed(options interface{}) {
+	if self == nil {
+		return
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	self.Status = FINISHED
+	self.Options = options
+	self.finish(time.Now(), "Job finished")
+}
+
+// Fail rolls back every step registered with OnRollback and finishes the job
+// with err. Only the first call does anything.
+func (self *Job) Fail(err error) {
+	if self == nil {
+		return
+	}
+
+	mutex.Lock()
+	if self.failing || self.Finished() {
+		mutex.Unlock()
+		return
+	}
+	self.failing = true
+	mutex.Unlock()
+
+	self.Rollback()
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	self.Status = FAILED
+	self.Error = err
+	self.ErrorString = err.Error()
+	self.finish(time.Now(), "Job failed: "+err.Error())
+}
+
+// Snapshot returns a copy of the job that can be read while the job itself
+// keeps changing.
+func (self *Job) Snapshot() Job {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	return self.clone()
+}
+
+func (self *Job) finish(now time.Time, line string) {
+	self.finishStage(now)
+	self.addLine(now, line)
+
+	err := Storage.set(self, true)
+	if err != nil {
+		log.Warnln("Could not write job to the job log:", err)
+	}
+}
+
+// clone copies the job including its stages and log, the caller holds the
+// mutex.
+func (self *Job) clone() Job {
+	job := *self
+	job.Stages = append([]Stage(nil), self.Stages...)
+	job.Log = append([]LogLine(nil), self.Log...)
+	job.RolledBack = append([]Undone(nil), self.RolledBack...)
+	job.rollback = nil
+	return job
+}
+
+// Stage finishes the running stage and starts the next one. Calling it for
+// the stage that is already running does nothing.
+func (self *Job) Stage(name string) {
+	if self == nil {
+		return
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if len(self.Stages) > 0 && self.Stages[len(self.Stages)-1].Name == name {
+		return
+	}
+
+	now := time.Now()
+	self.finishStage(now)
+	self.Stages = append(self.Stages, Stage{Name: name, StartedAt: now})
+	self.addLine(now, name)
+
+	Storage.set(self, true)
+}
+
+// Progress records how far along the running stage is, for instance the
+// amount of bytes pulled for an image.
+func (self *Job) Progress(current int64, total int64) {
+	if self == nil {
+		return
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if len(self.Stages) == 0 {
+		return
+	}
+	stage := &self.Stages[len(self.Stages)-1]
+	stage.Current = current
+	stage.Total = total
+
+	Storage.set(self, false)
+}
+
+func (self *Job) Logf(format string, args ...interface{}) {
+	if self == nil {
+		return
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	self.addLine(time.Now(), fmt.Sprintf(format, args...))
+
+	// Log lines are written to disk together with the next stage or status
+	// change, writing the whole job for every line gets expensive.
+	Storage.set(self, false)
+}
+
+// Context is cancelled when the job gets cancelled, long running steps such
+// as image pulls should respect it.
+func (self *Job) Context() context.Context {
+	if self == nil || self.ctx == nil {
+		return context.Background()
+	}
+	return self.ctx
+}
+
+// OnRollback registers how to undo a step that was just completed. When the
+// job fails or is cancelled the steps are undone in reverse order.
+func (self *Job) OnRollback(name string, undo func() error) {
+	if self == nil {
+		return
+	}
+
+	mutex.Lock()
+	self.rollback = append(self.rollback, rollbackStep{name: name, undo: undo})
+	mutex.Unlock()
+}
+
+// Rollback undoes all registered steps, newest first, and records them in
+// RolledBack.
+func (self *Job) Rollback() {
+	if self == nil {
+		return
+	}
+
+	mutex.Lock()
+	steps := self.rollback
+	self.rollback = nil
+	mutex.Unlock()
+
+	if len(steps) == 0 {
+		return
+	}
+	self.Stage(StageRollback)
+
+	for i := len(steps) - 1; i >= 0; i-- {
+		step := steps[i]
+		undone := Undone{Step: step.name}
+		err := step.undo()
+		if err != nil {
+			log.Warnf("Could not roll back '%s' for job %s: %s", step.name, self.Id, err)
+			self.Logf("Could not roll back %s: %s", step.name, err)
+			undone.Error = err.Error()
+		} else {
+			self.Logf("Rolled back %s", step.name)
+		}
+
+		mutex.Lock()
+		self.RolledBack = append(self.RolledBack, undone)
+		mutex.Unlock()
+	}
+}
+
+// Tail returns the log lines from cursor onwards.
+func (self *Job) Tail(cursor int) []LogLine {
+	lines := []LogLine{}
+	for _, line := range self.Log {
+		if line.Cursor >= cursor {
+			lines = append(lines, line)
+		}
+	}
+	return lines
 }
 
-// This is a very easy memory storage like solution, we might need something more durable at one point.
+func (self *Job) addLine(now time.Time, line string) {
+	self.Log = append(self.Log, LogLine{Cursor: self.LogCursor, Time: now, Line: line})
+	self.LogCursor++
+
+	if len(self.Log) > MaxLogLines {
+		self.Log = append([]LogLine{}, self.Log[len(self.Log)-MaxLogLines:]...)
+	}
+}
+
+func (self *Job) finishStage(now time.Time) {
+	if len(self.Stages) == 0 {
+		return
+	}
+	stage := &self.Stages[len(self.Stages)-1]
+	if stage.FinishedAt == nil {
+		stage.FinishedAt = &now
+	}
+}
+
+// JobStorage keeps all jobs in memory. Once opened every change is also
+// appended to a JSON log on disk so jobs survive a restart of the daemon.
 type JobStorage struct {
-	Jobs map[string]Job
+	Jobs      map[string]Job
+	file      *os.File
+	filePath  string
+	retention time.Duration
+	// Bytes in the log, and right after the last compaction.
+	size      int64
+	compacted int64
 }
 
 var Storage JobStorage
@@ -32,23 +381,285 @@ func init() {
 	Storage.Jobs = make(map[string]Job)
 }
 
-func (self *JobStorage) Set(jobId string, job *Job) error {
+// Open loads the jobs from the log in folder and keeps appending to it from
+// then on. Jobs that were still busy when the daemon stopped are marked as
+// failed and finished jobs older than retention are pruned.
+func (self *JobStorage) Open(folder string, retention time.Duration) error {
 	mutex.Lock()
-	self.Jobs[jobId] = *job
-	mutex.Unlock()
+	defer mutex.Unlock()
+
+	err := core.EnsurePath(folder)
+	if err != nil {
+		return err
+	}
+
+	self.filePath = path.Join(folder, logFileName)
+	self.retention = retention
+
+	err = self.load()
+	if err != nil {
+		return err
+	}
+
+	for id, job := range self.Jobs {
+		if job.Status == BUSY {
+			log.Infof("Job %s was interrupted by a restart, marking it as failed", id)
+			job.Status = FAILED
+			job.ErrorString = "Job was interrupted by a restart of the daemon"
+			job.UpdatedAt = time.Now()
+			self.Jobs[id] = job
+		}
+	}
+	self.prune()
+
+	err = self.compact()
+	if err != nil {
+		return err
+	}
+
+	go self.pruneLoop()
 
 	return nil
 }
 
+func (self *JobStorage) Set(jobId string, job *Job) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if job.Finished() {
+		job.finishStage(time.Now())
+	}
+
+	return self.set(job, true)
+}
+
+func (self *JobStorage) set(job *Job, persist bool) error {
+	job.UpdatedAt = time.Now()
+
+	// Only the first time a job finishes triggers its callback.
+	previous, known := self.Jobs[job.Id]
+	if job.Finished() && job.CallbackUrl != "" && !(known && previous.Finished()) {
+		go notify(job.clone())
+	}
+
+	self.Jobs[job.Id] = job.clone()
+
+	if !persist {
+		return nil
+	}
+
+	event := JobEvent{Id: job.Id, Plugin: job.Plugin, Status: job.Status, ErrorString: job.ErrorString}
+	if len(job.Stages) > 0 {
+		event.Stage = job.Stages[len(job.Stages)-1].Name
+	}
+	events.Publish(events.TopicJobs, "job.updated", event)
+
+	return self.write(job)
+}
+
+// Get returns a copy of the job, an empty job is returned for unknown ids.
 func (self *JobStorage) Get(jobId string) *Job {
+	mutex.Lock()
+	defer mutex.Unlock()
+
 	job := self.Jobs[jobId]
+	job = job.clone()
 	return &job
 }
 
-func New(opts interface{}) *Job {
+// List returns the jobs matching filter, newest first.
+func (self *JobStorage) List(filter ListFilter) []Job {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	res := []Job{}
+	for _, job := range self.Jobs {
+		if filter.Match(&job) {
+			res = append(res, job.clone())
+		}
+	}
+
+	sort.Sort(newestFirst(res))
+	return res
+}
+
+type newestFirst []Job
+
+func (s newestFirst) Len() int           { return len(s) }
+func (s newestFirst) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s newestFirst) Less(i, j int) bool { return s[i].CreatedAt.After(s[j].CreatedAt) }
+
+// Prune removes finished jobs that are older than the retention period.
+func (self *JobStorage) Prune() error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if self.prune() == 0 {
+		return nil
+	}
+	return self.compact()
+}
+
+func (self *JobStorage) prune() int {
+	if self.retention <= 0 {
+		return 0
+	}
+
+	pruned := 0
+	cutoff := time.Now().Add(-self.retention)
+	for id, job := range self.Jobs {
+		if job.Finished() && job.UpdatedAt.Before(cutoff) {
+			delete(self.Jobs, id)
+			pruned++
+		}
+	}
+
+	if pruned > 0 {
+		log.Debugf("Pruned %d finished jobs", pruned)
+	}
+	return pruned
+}
+
+func (self *JobStorage) pruneLoop() {
+	for range time.Tick(time.Hour) {
+		err := self.Prune()
+		if err != nil {
+			log.Warnln("Could not prune the job log:", err)
+		}
+	}
+}
+
+// load replays the log, later entries for a job replace earlier ones.
+func (self *JobStorage) load() error {
+	file, err := os.Open(self.filePath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		job := Job{}
+		err := json.Unmarshal(scanner.Bytes(), &job)
+		if err != nil {
+			// A crash can leave a half written last line behind, skip it.
+			log.Warnln("Skipping unreadable entry in the job log:", err)
+			continue
+		}
+		self.Jobs[job.Id] = job
+	}
+
+	return scanner.Err()
+}
+
+// compact rewrites the log so it only holds the current state of every job.
+func (self *JobStorage) compact() error {
+	tmpPath := self.filePath + ".tmp"
+	tmp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	size := int64(0)
+	for _, job := range self.Jobs {
+		data, err := json.Marshal(persisted(&job))
+		if err == nil {
+			_, err = tmp.Write(append(data, '\n'))
+		}
+		if err != nil {
+			tmp.Close()
+			return err
+		}
+		size += int64(len(data) + 1)
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	if self.file != nil {
+		self.file.Close()
+	}
+
+	err = os.Rename(tmpPath, self.filePath)
+	if err != nil {
+		return err
+	}
+
+	self.size, self.compacted = size, size
+	self.file, err = os.OpenFile(self.filePath, os.O_APPEND|os.O_WRONLY, 0600)
+	return err
+}
+
+func (self *JobStorage) write(job *Job) error {
+	if self.file == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(persisted(job))
+	if err != nil {
+		return err
+	}
+
+	n, err := self.file.Write(append(data, '\n'))
+	self.size += int64(n)
+	if err != nil {
+		return err
+	}
+
+	if self.size > maxLogFileSize && self.size > 2*self.compacted {
+		return self.compact()
+	}
+	return nil
+}
+
+// persisted returns the job as it is written to disk, without the secrets
+// in its options.
+func persisted(job *Job) *Job {
+	res := job.clone()
+	if res.Options != nil {
+		res.Options = core.Redact(res.Options)
+	}
+	return &res
+}
+
+// Cancel stops a job that is queued or running.
+func Cancel(jobId string) error {
+	mutex.Lock()
+	job, ok := Storage.Jobs[jobId]
+	mutex.Unlock()
+
+	if !ok {
+		return fmt.Errorf("Could not find job '%s'", jobId)
+	}
+	if job.Finished() {
+		return fmt.Errorf("Job '%s' already finished", jobId)
+	}
+	if job.cancel == nil {
+		return fmt.Errorf("Job '%s' can not be cancelled", jobId)
+	}
+
+	log.Infof("Cancelling job %s", jobId)
+	job.cancel()
+	return nil
+}
+
+// New creates a job and stores it. The callback URL, which may be empty, is
+// told about the outcome.
+func New(plugin string, opts interface{}, callbackUrl string) *Job {
 	id := fmt.Sprintf("%02X", core.GetRandom(8))
-	job := Job{Id: id, Status: BUSY, Options: opts}
-	Storage.Jobs[job.Id] = job
+	job := Job{Id: id, Plugin: plugin, Status: BUSY, Options: opts, CallbackUrl: callbackUrl, CreatedAt: time.Now()}
+	job.ctx, job.cancel = context.WithCancel(context.Background())
+
+	err := Storage.Set(job.Id, &job)
+	if err != nil {
+		log.Warnln("Could not write job to the job log:", err)
+	}
 
 	return &job
 }
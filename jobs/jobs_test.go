@@ -0,0 +1,289 @@
+package jobs
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestStorageSurvivesRestart(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bcdjobs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	storage := JobStorage{Jobs: map[string]Job{}}
+	err = storage.Open(dir, DefaultRetention)
+	if err != nil {
+		t.Fatal("Could not open job storage:", err)
+	}
+
+	storage.Set("busy", &Job{Id: "busy", Status: BUSY})
+	storage.Set("done", &Job{Id: "done", Status: FINISHED})
+	storage.Set("old", &Job{Id: "old", Status: FINISHED})
+
+	// Age one of the finished jobs past the retention period.
+	old := storage.Jobs["old"]
+	old.UpdatedAt = time.Now().Add(-2 * DefaultRetention)
+	storage.write(&old)
+
+	restarted := JobStorage{Jobs: map[string]Job{}}
+	err = restarted.Open(dir, DefaultRetention)
+	if err != nil {
+		t.Fatal("Could not reopen job storage:", err)
+	}
+
+	busy := restarted.Get("busy")
+	if busy.Status != FAILED || busy.ErrorString == "" {
+		t.Error("Busy job was not marked as failed after a restart:", busy)
+	}
+	if restarted.Get("done").Status != FINISHED {
+		t.Error("Finished job got lost during a restart")
+	}
+	if _, ok := restarted.Jobs["old"]; ok {
+		t.Error("Job older than the retention period was not pruned")
+	}
+}
+
+func TestStorageRedactsSecrets(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bcdjobs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	storage := JobStorage{Jobs: map[string]Job{}}
+	err = storage.Open(dir, DefaultRetention)
+	if err != nil {
+		t.Fatal("Could not open job storage:", err)
+	}
+
+	storage.Set("install", &Job{Id: "install", Status: FINISHED, Options: map[string]string{"password": "hunter2", "web_port": "8080"}})
+	storage.compact()
+
+	data, _ := ioutil.ReadFile(filepath.Join(dir, logFileName))
+	if strings.Contains(string(data), "hunter2") || !strings.Contains(string(data), "8080") {
+		t.Error("Expected the password to be left out of the job log:", string(data))
+	}
+	if options := storage.Get("install").Options.(map[string]string); options["password"] != "hunter2" {
+		t.Error("Expected the job in memory to keep its options")
+	}
+}
+
+func TestJobStagesAndTail(t *testing.T) {
+	job := New("test", nil, "")
+	job.Stage(StagePull)
+	job.Progress(10, 100)
+	job.Stage(StageCreate)
+
+	for i := 0; i < MaxLogLines+10; i++ {
+		job.Logf("line %d", i)
+	}
+
+	stored := Storage.Get(job.Id)
+	if len(stored.Stages) != 2 {
+		t.Fatal("Expected two stages, got:", stored.Stages)
+	}
+	if stored.Stages[0].FinishedAt == nil || stored.Stages[0].Total != 100 {
+		t.Error("First stage was not finished or lost its progress:", stored.Stages[0])
+	}
+	if len(stored.Log) != MaxLogLines {
+		t.Error("Log is not bounded, it has", len(stored.Log), "lines")
+	}
+
+	tail := stored.Tail(stored.LogCursor - 3)
+	if len(tail) != 3 || tail[2].Line != fmt.Sprintf("line %d", MaxLogLines+9) {
+		t.Error("Tail did not return the last lines:", tail)
+	}
+}
+
+func TestRunnerCancel(t *testing.T) {
+	runner := NewRunner(1)
+	release := make(chan bool)
+	started := make(chan bool)
+	rolledBack := make(chan bool, 1)
+
+	running := New("test", nil, "")
+	runner.Run(running, func() {
+		running.OnRollback("test step", func() error {
+			rolledBack <- true
+			return nil
+		})
+		close(started)
+		select {
+		case <-release:
+		case <-running.Context().Done():
+		}
+	})
+
+	<-started
+	queued := New("test", nil, "")
+	ran := make(chan bool, 1)
+	runner.Run(queued, func() { ran <- true })
+
+	err := Cancel(queued.Id)
+	if err != nil {
+		t.Fatal("Could not cancel queued job:", err)
+	}
+	err = Cancel(running.Id)
+	if err != nil {
+		t.Fatal("Could not cancel running job:", err)
+	}
+
+	select {
+	case <-rolledBack:
+	case <-time.After(time.Second):
+		t.Fatal("Cancelled job was not rolled back")
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		mutex.Lock()
+		job := Storage.Jobs[queued.Id]
+		mutex.Unlock()
+		if job.Finished() {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	mutex.Lock()
+	status := Storage.Jobs[queued.Id].Status
+	mutex.Unlock()
+	if status != FAILED || len(ran) > 0 {
+		t.Error("Queued job was not cancelled before it ran")
+	}
+}
+
+func TestFailRollsBack(t *testing.T) {
+	job := New("test", nil, "")
+	undone := []string{}
+	job.OnRollback("first step", func() error {
+		undone = append(undone, "first")
+		return nil
+	})
+	job.OnRollback("second step", func() error {
+		undone = append(undone, "second")
+		return fmt.Errorf("still there")
+	})
+
+	job.Fail(fmt.Errorf("Could not start container"))
+
+	snapshot := job.Snapshot()
+	if len(undone) != 2 || undone[0] != "second" {
+		t.Error("Steps were not undone newest first:", undone)
+	}
+	if len(snapshot.RolledBack) != 2 || snapshot.RolledBack[0].Error != "still there" || snapshot.RolledBack[1].Error != "" {
+		t.Error("Rolled back steps were not recorded:", snapshot.RolledBack)
+	}
+	if snapshot.Status != FAILED || snapshot.Stages[len(snapshot.Stages)-1].Name != StageRollback {
+		t.Error("Job did not fail in the rollback stage:", snapshot.Status, snapshot.Stages)
+	}
+
+	job.Fail(fmt.Errorf("Failed again"))
+	if len(undone) != 2 {
+		t.Error("Steps were undone twice")
+	}
+	if again := job.Snapshot(); again.ErrorString != "Could not start container" || len(again.Log) != len(snapshot.Log) {
+		t.Error("Failing a failed job changed it:", again.ErrorString)
+	}
+}
+
+func TestListAndCallback(t *testing.T) {
+	received := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies <- body
+		received <- r
+	}))
+	defer server.Close()
+	CallbackSecret = "secret"
+
+	since := time.Now()
+	first := New("listed", nil, "")
+	second := New("listed", nil, server.URL)
+	second.Succeed(nil)
+	New("other", nil, "")
+
+	listed := Storage.List(ListFilter{Plugin: "listed", Since: since})
+	if len(listed) != 2 || listed[0].Id != second.Id || listed[1].Id != first.Id {
+		t.Error("Expected both jobs of the plugin newest first, got:", listed)
+	}
+	if finished := Storage.List(ListFilter{Plugin: "listed", Status: FINISHED}); len(finished) != 1 {
+		t.Error("Status filter did not match one job, got:", finished)
+	}
+
+	select {
+	case r := <-received:
+		body := <-bodies
+		if r.Header.Get(SignatureHeader) != Sign("secret", body) {
+			t.Error("Callback has an invalid signature")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Callback was not delivered")
+	}
+
+	// Saving the finished job again must not repeat the callback.
+	Storage.Set(second.Id, second)
+	select {
+	case <-received:
+		t.Error("Callback was delivered twice")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// Run with -race, installs keep updating their jobs while they are read.
+func TestConcurrentJobUpdates(t *testing.T) {
+	runner := NewRunner(4)
+	done := make(chan bool)
+	wg := sync.WaitGroup{}
+
+	for i := 0; i < 20; i++ {
+		job := New(fmt.Sprintf("concurrent%d", i%5), nil, "")
+		wg.Add(1)
+		runner.Run(job, func() {
+			defer wg.Done()
+			job.Stage(StagePull)
+			for n := 0; n < 50; n++ {
+				job.Progress(int64(n), 50)
+				job.Logf("line %d", n)
+			}
+			job.Stage(StageStart)
+			if n := len(job.Snapshot().Log); n%2 == 0 {
+				job.Succeed(n)
+			} else {
+				job.Fail(fmt.Errorf("odd"))
+			}
+		})
+
+		go func() {
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				Storage.Get(job.Id).Tail(0)
+				Storage.List(ListFilter{Status: FINISHED})
+				time.Sleep(time.Millisecond)
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(done)
+
+	for _, job := range Storage.List(ListFilter{}) {
+		if len(job.Plugin) > 10 && job.Plugin[:10] == "concurrent" && !job.Finished() {
+			t.Error("Job did not finish:", job.Id)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package jobs
+
+import (
+	"errors"
+	"sync"
+)
+
+const DefaultConcurrency = 2
+
+var ErrCancelled = errors.New("Job was cancelled")
+
+// Runner runs jobs in the background. It limits how many jobs run at the same
+// time and never runs two jobs of the same plugin at once.
+type Runner struct {
+	slots   chan bool
+	plugins map[string]chan bool
+	lock    sync.Mutex
+}
+
+var Queue = NewRunner(DefaultConcurrency)
+
+func NewRunner(concurrency int) *Runner {
+	if concurrency < 1 {
+		concurrency = DefaultConcurrency
+	}
+	return &Runner{slots: make(chan bool, concurrency), plugins: map[string]chan bool{}}
+}
+
+// Run queues fn for the job. When the job gets cancelled before fn finishes
+// it fails, which undoes every step registered with OnRollback.
+func (self *Runner) Run(job *Job, fn func()) {
+	go func() {
+		ctx := job.Context()
+		pluginSlot := self.pluginSlot(job.Plugin)
+
+		job.Logf("Waiting in the job queue")
+
+		select {
+		case pluginSlot <- true:
+			defer func() { <-pluginSlot }()
+		case <-ctx.Done():
+			job.Fail(ErrCancelled)
+			return
+		}
+
+		select {
+		case self.slots <- true:
+			defer func() { <-self.slots }()
+		case <-ctx.Done():
+			job.Fail(ErrCancelled)
+			return
+		}
+
+		if ctx.Err() != nil {
+			job.Fail(ErrCancelled)
+			return
+		}
+
+		job.Start()
+		fn()
+
+		// Fail does nothing when fn already finished the job.
+		if ctx.Err() != nil {
+			job.Fail(ErrCancelled)
+		}
+	}()
+}
+
+func (self *Runner) pluginSlot(plugin string) chan bool {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	slot, ok := self.plugins[plugin]
+	if !ok {
+		slot = make(chan bool, 1)
+		self.plugins[plugin] = slot
+	}
+	return slot
+}
@@ -2,37 +2,48 @@
 package main
 
 import (
+	"fmt"
 	log "github.com/Sirupsen/logrus"
+	"github.com/bytesizedhosting/bcd/audit"
 	"github.com/bytesizedhosting/bcd/core"
 	"github.com/bytesizedhosting/bcd/engines"
-	"github.com/bytesizedhosting/bcd/plugins/cardigann"
-	"github.com/bytesizedhosting/bcd/plugins/couchpotato"
-	"github.com/bytesizedhosting/bcd/plugins/deluge"
-	"github.com/bytesizedhosting/bcd/plugins/filebot"
-	"github.com/bytesizedhosting/bcd/plugins/headphones"
-	"github.com/bytesizedhosting/bcd/plugins/jackett"
-	"github.com/bytesizedhosting/bcd/plugins/jobs"
-	"github.com/bytesizedhosting/bcd/plugins/murmur"
-	"github.com/bytesizedhosting/bcd/plugins/nzbget"
-	"github.com/bytesizedhosting/bcd/plugins/plex"
-	"github.com/bytesizedhosting/bcd/plugins/plexpy"
-	"github.com/bytesizedhosting/bcd/plugins/plexrequests"
-	"github.com/bytesizedhosting/bcd/plugins/portainer"
-	"github.com/bytesizedhosting/bcd/plugins/proxy"
-	"github.com/bytesizedhosting/bcd/plugins/radarr"
-	"github.com/bytesizedhosting/bcd/plugins/resilio"
-	"github.com/bytesizedhosting/bcd/plugins/rocketchat"
-	"github.com/bytesizedhosting/bcd/plugins/rtorrent"
-	"github.com/bytesizedhosting/bcd/plugins/sickrage"
-	"github.com/bytesizedhosting/bcd/plugins/sonarr"
-	"github.com/bytesizedhosting/bcd/plugins/stats"
-	"github.com/bytesizedhosting/bcd/plugins/subsonic"
-	"github.com/bytesizedhosting/bcd/plugins/syncthing"
-	"github.com/bytesizedhosting/bcd/plugins/vnc"
-	"github.com/bytesizedhosting/bcd/plugins/znc"
+	jobstore "github.com/bytesizedhosting/bcd/jobs"
+	"github.com/bytesizedhosting/bcd/plugins"
+	_ "github.com/bytesizedhosting/bcd/plugins/cardigann"
+	_ "github.com/bytesizedhosting/bcd/plugins/couchpotato"
+	_ "github.com/bytesizedhosting/bcd/plugins/deluge"
+	_ "github.com/bytesizedhosting/bcd/plugins/filebot"
+	"github.com/bytesizedhosting/bcd/plugins/generic"
+	_ "github.com/bytesizedhosting/bcd/plugins/headphones"
+	_ "github.com/bytesizedhosting/bcd/plugins/jackett"
+	_ "github.com/bytesizedhosting/bcd/plugins/jobs"
+	_ "github.com/bytesizedhosting/bcd/plugins/murmur"
+	_ "github.com/bytesizedhosting/bcd/plugins/nzbget"
+	_ "github.com/bytesizedhosting/bcd/plugins/plex"
+	_ "github.com/bytesizedhosting/bcd/plugins/plexpy"
+	_ "github.com/bytesizedhosting/bcd/plugins/plexrequests"
+	_ "github.com/bytesizedhosting/bcd/plugins/portainer"
+	_ "github.com/bytesizedhosting/bcd/plugins/proxy"
+	_ "github.com/bytesizedhosting/bcd/plugins/radarr"
+	_ "github.com/bytesizedhosting/bcd/plugins/resilio"
+	_ "github.com/bytesizedhosting/bcd/plugins/rocketchat"
+	_ "github.com/bytesizedhosting/bcd/plugins/rtorrent"
+	_ "github.com/bytesizedhosting/bcd/plugins/sickrage"
+	_ "github.com/bytesizedhosting/bcd/plugins/sonarr"
+	_ "github.com/bytesizedhosting/bcd/plugins/stats"
+	_ "github.com/bytesizedhosting/bcd/plugins/subsonic"
+	_ "github.com/bytesizedhosting/bcd/plugins/syncthing"
+	_ "github.com/bytesizedhosting/bcd/plugins/vnc"
+	_ "github.com/bytesizedhosting/bcd/plugins/znc"
 	"github.com/fsouza/go-dockerclient"
+	"github.com/ghodss/yaml"
 	"gopkg.in/alecthomas/kingpin.v2"
+	"io/ioutil"
 	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
 )
 
 var (
@@ -52,8 +63,29 @@ var (
 	register  = app.Command("init", "Initialize this instance of bcd")
 	apikey    = register.Arg("apikey", "Apikey supplied by your provider").Required().String()
 	apisecret = register.Arg("apisecret", "Apisecret supplied by your provider").Required().String()
+	tlsCert   = register.Flag("tls-cert", "Certificate to serve RPC with, a self-signed one is generated when left empty").String()
+	tlsKey    = register.Flag("tls-key", "Key belonging to --tls-cert").String()
+	noTLS     = register.Flag("no-tls", "Serve RPC over plain HTTP").Bool()
+
+	tlsSetup   = app.Command("tls", "Set up the certificate RPC is served with, the rest of the config is kept")
+	tlsCertArg = tlsSetup.Flag("tls-cert", "Certificate to serve RPC with, a self-signed one is generated when left empty").String()
+	tlsKeyArg  = tlsSetup.Flag("tls-key", "Key belonging to --tls-cert").String()
 
 	start = app.Command("start", "Start BCD")
+
+	token         = app.Command("token", "Manage API tokens that may only call some methods")
+	tokenCreate   = token.Command("create", "Create a token and print its key and secret")
+	createName    = tokenCreate.Arg("name", "Name of the token").Required().String()
+	createMethods = tokenCreate.Arg("methods", "Methods the token may call, like 'Stats.*' or 'JobRPC.Get'").Required().Strings()
+	tokenList     = token.Command("list", "List all tokens")
+	tokenRevoke   = token.Command("revoke", "Revoke a token")
+	revokeName    = tokenRevoke.Arg("name", "Name of the token").Required().String()
+
+	manifest     = app.Command("manifest", "Inspect plugin manifests")
+	manifestShow = manifest.Command("show", "Print the manifest of a plugin with the custom manifest merged on top")
+	showPlugin   = manifestShow.Arg("plugin", "Name of the plugin").Required().String()
+	manifestLint = manifest.Command("lint", "Check a manifest for mistakes, all built-in and custom manifests are checked when no path is given")
+	lintPath     = manifestLint.Arg("path", "Manifest file to check").String()
 )
 
 func startApp(config *core.MainConfig) {
@@ -82,181 +114,264 @@ func startApp(config *core.MainConfig) {
 		os.Exit(1)
 	}
 
-	engine := engine.NewRpcEngine(config)
-
-	// Can we DRY this up?
-	deluge, err := deluge.New(dockerClient)
+	configPath, err := core.ConfigPath()
 	if err != nil {
-		log.Infoln("Could not enable plugin: ", err)
-	} else {
-		engine.Activate(deluge)
+		log.Errorf("Could not find config path: '%s'", err.Error())
+		os.Exit(1)
 	}
 
-	plex, err := plex.New(dockerClient)
-	if err != nil {
-		log.Infoln("Could not enable plugin: ", err)
-	} else {
-		engine.Activate(plex)
+	retention := jobstore.DefaultRetention
+	if config.JobRetentionHours > 0 {
+		retention = time.Duration(config.JobRetentionHours) * time.Hour
 	}
 
-	rocketchat, err := rocketchat.New(dockerClient)
+	err = jobstore.Storage.Open(path.Join(configPath, "jobs"), retention)
 	if err != nil {
-		log.Infoln("Could not enable plugin: ", err)
-	} else {
-		engine.Activate(rocketchat)
+		log.Errorf("Could not open job storage: '%s'", err.Error())
+		os.Exit(1)
 	}
+	jobstore.Queue = jobstore.NewRunner(config.MaxConcurrentJobs)
+	jobstore.CallbackSecret = config.ApiSecret
 
-	syncthing, err := syncthing.New(dockerClient)
+	err = audit.Default.Open(path.Join(configPath, "audit"))
 	if err != nil {
-		log.Infoln("Could not enable plugin: ", err)
-	} else {
-		engine.Activate(syncthing)
+		log.Errorf("Could not open the audit log: '%s'", err.Error())
+		os.Exit(1)
 	}
 
-	sickrage, err := sickrage.New(dockerClient)
-	if err != nil {
-		log.Infoln("Could not enable plugin: ", err)
-	} else {
-		engine.Activate(sickrage)
+	if config.CrashLoopDeaths > 0 {
+		plugins.Supervise.Deaths = config.CrashLoopDeaths
 	}
-
-	couchpotato, err := couchpotato.New(dockerClient)
-	if err != nil {
-		log.Infoln("Could not enable plugin: ", err)
-	} else {
-		engine.Activate(couchpotato)
+	if config.CrashLoopMinutes > 0 {
+		plugins.Supervise.Window = time.Duration(config.CrashLoopMinutes) * time.Minute
 	}
 
-	plexpy, err := plexpy.New(dockerClient)
-	if err != nil {
-		log.Infoln("Could not enable plugin: ", err)
-	} else {
-		engine.Activate(plexpy)
-	}
+	go func() {
+		err := plugins.WatchContainers(dockerClient)
+		if err != nil {
+			log.Warnln("Could not watch Docker events:", err)
+		}
+	}()
 
-	rtorrent, err := rtorrent.New(dockerClient)
+	err = generic.RegisterManifests(path.Join(configPath, "manifests"))
 	if err != nil {
-		log.Infoln("Could not enable plugin: ", err)
-	} else {
-		engine.Activate(rtorrent)
+		log.Warnln("Could not load generic app manifests:", err)
 	}
 
-	nzbget, err := nzbget.New(dockerClient)
-	if err != nil {
-		log.Infoln("Could not enable plugin: ", err)
-	} else {
-		engine.Activate(nzbget)
-	}
+	engine := engine.NewRpcEngine(config, dockerClient)
 
-	sonarr, err := sonarr.New(dockerClient)
-	if err != nil {
-		log.Infoln("Could not enable plugin: ", err)
-	} else {
-		engine.Activate(sonarr)
-	}
+	engine.ActivateRegistered()
+	engine.StartUpdateChecker()
+	engine.StartHealthMonitor()
+	engine.Start()
+}
 
-	cardigann, err := cardigann.New(dockerClient)
-	if err != nil {
-		log.Infoln("Could not enable plugin: ", err)
-	} else {
-		engine.Activate(cardigann)
+// setupTLS uses the supplied certificate or generates a self-signed one in
+// the config folder, the fingerprint is printed so the manager can pin it.
+func setupTLS(c *core.MainConfig, certFile string, keyFile string, disabled bool) error {
+	if disabled {
+		c.TLSCert, c.TLSKey = "", ""
+		log.Warnln("TLS is disabled, RPC traffic will not be encrypted.")
+		return nil
 	}
 
-	plexrequests, err := plexrequests.New(dockerClient)
-	if err != nil {
-		log.Infoln("Could not enable plugin: ", err)
+	if certFile != "" {
+		if keyFile == "" {
+			return fmt.Errorf("--tls-key is required together with --tls-cert")
+		}
+		var err error
+		c.TLSCert, err = filepath.Abs(certFile)
+		if err != nil {
+			return err
+		}
+		c.TLSKey, err = filepath.Abs(keyFile)
+		if err != nil {
+			return err
+		}
 	} else {
-		engine.Activate(plexrequests)
-	}
+		configPath, err := core.ConfigPath()
+		if err != nil {
+			return err
+		}
+		err = core.EnsurePath(configPath)
+		if err != nil {
+			return err
+		}
 
-	subsonic, err := subsonic.New(dockerClient)
-	if err != nil {
-		log.Infoln("Could not enable plugin: ", err)
-	} else {
-		engine.Activate(subsonic)
+		c.TLSCert = path.Join(configPath, "tls.crt")
+		c.TLSKey = path.Join(configPath, "tls.key")
+		if _, err := os.Stat(c.TLSCert); err == nil {
+			log.Infoln("Keeping the existing certificate in", c.TLSCert)
+		} else {
+			log.Infoln("Generating a self-signed certificate in", c.TLSCert)
+			err = core.GenerateCertificate(c.TLSCert, c.TLSKey)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
-	murmur, err := murmur.New(dockerClient)
+	_, err := core.TLSConfig(c.TLSCert, c.TLSKey)
 	if err != nil {
-		log.Infoln("Could not enable plugin: ", err)
-	} else {
-		engine.Activate(murmur)
+		return err
 	}
 
-	filebot, err := filebot.New(dockerClient)
+	fingerprint, err := core.CertificateFingerprint(c.TLSCert)
 	if err != nil {
-		log.Infoln("Could not enable plugin: ", err)
-	} else {
-		engine.Activate(filebot)
+		return err
 	}
+	log.Infoln("TLS certificate fingerprint (SHA256):", fingerprint)
+	return nil
+}
 
-	resilio, err := resilio.New(dockerClient)
+// manageTokens runs the token subcommands, the daemon picks up changes on
+// its next start.
+func manageTokens(command string) error {
+	c := core.MainConfig{}
+	err := core.LoadHomeConfig("config.json", &c)
 	if err != nil {
-		log.Infoln("Could not enable plugin: ", err)
-	} else {
-		engine.Activate(resilio)
+		return fmt.Errorf("Could not load config file, please run 'bcd init' first: %s", err)
 	}
 
-	headphones, err := headphones.New(dockerClient)
-	if err != nil {
-		log.Infoln("Could not enable plugin: ", err)
-	} else {
-		engine.Activate(headphones)
+	switch command {
+	case tokenCreate.FullCommand():
+		t, err := core.NewToken(*createName, *createMethods)
+		if err != nil {
+			return err
+		}
+		err = c.AddToken(t)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Created token '%s'\nKey:    %s\nSecret: %s\n", t.Name, t.Key, t.Secret)
+	case tokenList.FullCommand():
+		for _, t := range c.Tokens {
+			fmt.Printf("%s\t%s\t%s\t%s\n", t.Name, t.Key, t.CreatedAt.Format(time.RFC3339), strings.Join(t.Methods, ","))
+		}
+		return nil
+	case tokenRevoke.FullCommand():
+		err = c.RevokeToken(*revokeName)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Revoked token '%s'\n", *revokeName)
 	}
 
-	jackett, err := jackett.New(dockerClient)
+	err = core.WriteConfig("config.json", &c)
 	if err != nil {
-		log.Infoln("Could not enable plugin: ", err)
-	} else {
-		engine.Activate(jackett)
+		return err
 	}
+	log.Infoln("Restart bcd to apply the changed tokens.")
+	return nil
+}
 
-	vnc, err := vnc.New(dockerClient)
-	if err != nil {
-		log.Infoln("Could not enable plugin: ", err)
-	} else {
-		engine.Activate(vnc)
+// lintManifests prints the problems in a manifest file, or in all built-in
+// and custom manifests when file is empty. Custom manifests for built-in
+// plugins are checked merged onto the built-in one. It returns the number of
+// problems found.
+func lintManifests(file string) (int, error) {
+	count := 0
+	report := func(source string, problems []string) {
+		for _, problem := range problems {
+			fmt.Printf("%s: %s\n", source, problem)
+		}
+		count += len(problems)
 	}
 
-	znc, err := znc.New(dockerClient)
-	if err != nil {
-		log.Infoln("Could not enable plugin: ", err)
-	} else {
-		engine.Activate(znc)
-	}
+	files := []string{file}
+	if file == "" {
+		for _, name := range plugins.Registered() {
+			builtin, err := plugins.Asset("plugins/" + name + "/data/manifest.yml")
+			if err != nil {
+				// Feature plugins have no manifest.
+				continue
+			}
+			p, err := plugins.Create(name, nil)
+			if err != nil {
+				return count, err
+			}
+			report(name, plugins.LintManifest(builtin, p))
+		}
 
-	radarr, err := radarr.New(dockerClient)
-	if err != nil {
-		log.Infoln("Could not enable plugin: ", err)
-	} else {
-		engine.Activate(radarr)
+		configPath, err := core.ConfigPath()
+		if err != nil {
+			return count, err
+		}
+		files, err = filepath.Glob(path.Join(configPath, "manifests", "*.yml"))
+		if err != nil {
+			return count, err
+		}
 	}
 
-	portainer, err := portainer.New(dockerClient)
-	if err != nil {
-		log.Infoln("Could not enable plugin: ", err)
-	} else {
-		engine.Activate(portainer)
+	for _, f := range files {
+		data, err := ioutil.ReadFile(f)
+		if err != nil {
+			return count, err
+		}
+
+		name := strings.ToLower(strings.TrimSuffix(path.Base(f), path.Ext(f)))
+		if builtin, err := plugins.Asset("plugins/" + name + "/data/manifest.yml"); err == nil {
+			p, err := plugins.Create(name, nil)
+			if err != nil {
+				return count, err
+			}
+			report(f, plugins.LintOverlay(builtin, data, p))
+			continue
+		}
+
+		// Anything else has to be an app for the generic plugin.
+		var p plugins.Plugin
+		if name, m, err := generic.Load(f); err == nil && m.Image != "" {
+			p = generic.New(nil, name, m, path.Dir(f))
+		}
+		report(f, plugins.LintManifest(data, p))
 	}
 
-	engine.Activate(stats.New())
-	engine.Activate(jobrpc.New())
-	engine.Activate(proxy.New())
-	engine.Start()
+	return count, nil
 }
 
 func main() {
 	log.Println("Starting the Bytesized Connect Daemon", core.VerString)
 
-	switch kingpin.MustParse(app.Parse(os.Args[1:])) {
+	command := kingpin.MustParse(app.Parse(os.Args[1:]))
+	switch command {
 	case register.FullCommand():
 		log.Infoln("Initialization run, writing config file.")
-		c := core.MainConfig{ApiSecret: *apisecret, ApiKey: *apikey, Port: *port}
-		err := core.WriteConfig("config.json", &c)
+		// Tokens and other settings of an earlier init are kept.
+		c := core.MainConfig{}
+		err := core.LoadHomeConfig("config.json", &c)
+		if err != nil && !os.IsNotExist(err) {
+			log.Panicf("Could not read the existing config file: '%s'", err.Error())
+		}
+		c.ApiSecret, c.ApiKey, c.Port = *apisecret, *apikey, *port
+
+		err = setupTLS(&c, *tlsCert, *tlsKey, *noTLS)
+		if err != nil {
+			log.Panicf("Could not set up TLS: '%s'", err.Error())
+		}
+		err = core.WriteConfig("config.json", &c)
 		if err != nil {
 			log.Panicf("Could not write config file: '%s'", err.Error())
 		}
 		log.Infoln("Initialization run completed, please start the daemon normally.")
+	case tlsSetup.FullCommand():
+		c := core.MainConfig{}
+		err := core.LoadHomeConfig("config.json", &c)
+		if err != nil {
+			log.Errorf("Could not load config file, please run 'bcd init' first: %s", err)
+			os.Exit(1)
+		}
+		err = setupTLS(&c, *tlsCertArg, *tlsKeyArg, false)
+		if err != nil {
+			log.Errorf("Could not set up TLS: %s", err)
+			os.Exit(1)
+		}
+		err = core.WriteConfig("config.json", &c)
+		if err != nil {
+			log.Errorf("Could not write config file: %s", err)
+			os.Exit(1)
+		}
+		log.Infoln("Restart bcd to serve RPC over TLS.")
 	case start.FullCommand():
 		c := core.MainConfig{}
 		c.Port = *port
@@ -269,6 +384,35 @@ func main() {
 
 		log.Debugf("Using docker socket '%s'", *endpoint)
 		startApp(&c)
+	case tokenCreate.FullCommand(), tokenList.FullCommand(), tokenRevoke.FullCommand():
+		err := manageTokens(command)
+		if err != nil {
+			log.Errorln(err)
+			os.Exit(1)
+		}
+	case manifestShow.FullCommand():
+		m, err := plugins.LoadManifest(strings.ToLower(*showPlugin))
+		if err != nil {
+			log.Errorf("Could not load the manifest of '%s': %s", *showPlugin, err)
+			os.Exit(1)
+		}
+		data, err := yaml.Marshal(m)
+		if err != nil {
+			log.Errorln(err)
+			os.Exit(1)
+		}
+		fmt.Print(string(data))
+	case manifestLint.FullCommand():
+		count, err := lintManifests(*lintPath)
+		if err != nil {
+			log.Errorln(err)
+			os.Exit(1)
+		}
+		if count > 0 {
+			log.Errorf("Found %d problems", count)
+			os.Exit(1)
+		}
+		log.Infoln("No problems found")
 	}
 
 }
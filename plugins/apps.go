@@ -0,0 +1,137 @@
+package plugins
+
+import (
+	"encoding/json"
+	"fmt"
+	log "github.com/Sirupsen/logrus"
+	"github.com/bytesizedhosting/bcd/core"
+	"github.com/fsouza/go-dockerclient"
+	"strconv"
+	"strings"
+)
+
+// Labels every plugin container carries so the list of installed apps can be
+// rebuilt from the Docker daemon alone.
+const (
+	LabelPlugin        = "bytesized.plugin"
+	LabelPluginVersion = "bytesized.plugin_version"
+	LabelInstance      = "bytesized.instance"
+	LabelOptions       = "bytesized.options"
+	// The restart policy the app was installed with, see RestartPolicyLabel.
+	LabelRestartPolicy = "bytesized.restart_policy"
+
+	ContainerPrefix = "bytesized_"
+)
+
+type App struct {
+	ContainerId   string                 `json:"container_id"`
+	ContainerName string                 `json:"container_name"`
+	Image         string                 `json:"image"`
+	State         string                 `json:"state"`
+	Plugin        string                 `json:"plugin,omitempty"`
+	PluginVersion int                    `json:"plugin_version,omitempty"`
+	Instance      string                 `json:"instance,omitempty"`
+	Options       map[string]interface{} `json:"options,omitempty"`
+	Problem       string                 `json:"problem,omitempty"`
+}
+
+// Options that are left out of the labels although they are not secret. The
+// user is looked up again from run_as_user.
+var hostOptions = map[string]bool{"user": true}
+
+// PublicOptions returns the options as a map with all secret values removed,
+// the options the manifest declares as secret included.
+func PublicOptions(opts interface{}, manifest *Manifest) (map[string]interface{}, error) {
+	data, err := json.Marshal(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	res := map[string]interface{}{}
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	for key := range res {
+		if core.IsSecretOption(key) || hostOptions[key] {
+			delete(res, key)
+		}
+	}
+	for _, key := range manifest.SecretOptions() {
+		delete(res, key)
+	}
+
+	return res, nil
+}
+
+func AppLabels(p Plugin, instance string, opts interface{}) (map[string]string, error) {
+	public, err := PublicOptions(opts, p.GetManifest())
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(public)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]string{
+		LabelPlugin:        p.GetName(),
+		LabelPluginVersion: strconv.Itoa(p.GetVersion()),
+		LabelInstance:      instance,
+		LabelOptions:       string(data),
+	}, nil
+}
+
+// FindApps returns every container that was created by bcd, either because it
+// carries our labels or because it follows the bytesized_ naming scheme.
+func FindApps(client *docker.Client) ([]*App, error) {
+	containers, err := client.ListContainers(docker.ListContainersOptions{All: true})
+	if err != nil {
+		return nil, err
+	}
+
+	apps := []*App{}
+	for _, c := range containers {
+		name := containerName(c.Names)
+		_, labeled := c.Labels[LabelPlugin]
+
+		if !labeled && !strings.HasPrefix(name, ContainerPrefix) {
+			continue
+		}
+
+		app := &App{ContainerId: c.ID, ContainerName: name, Image: c.Image, State: c.State}
+
+		if !labeled {
+			app.Problem = "Container has no bytesized labels"
+			apps = append(apps, app)
+			continue
+		}
+
+		app.Plugin = c.Labels[LabelPlugin]
+		app.Instance = c.Labels[LabelInstance]
+		app.PluginVersion, err = strconv.Atoi(c.Labels[LabelPluginVersion])
+		if err != nil {
+			log.Debugf("Container %s has an invalid plugin version label: %s", name, err)
+		}
+
+		if options, ok := c.Labels[LabelOptions]; ok {
+			err = json.Unmarshal([]byte(options), &app.Options)
+			if err != nil {
+				app.Problem = fmt.Sprintf("Could not parse options label: %s", err)
+			}
+		}
+
+		apps = append(apps, app)
+	}
+
+	return apps, nil
+}
+
+func containerName(names []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	return strings.TrimPrefix(names[0], "/")
+}
@@ -0,0 +1,40 @@
+package plugins
+
+import (
+	"os/user"
+	"strings"
+	"testing"
+)
+
+func TestAppLabels(t *testing.T) {
+	b := Base{Name: "rtorrent", Version: 2}
+	opts := &TestOpts{BaseOpts: BaseOpts{Password: "secret", WebPort: "1234", User: &user.User{Username: "jan"}}, DhtPort: "4321"}
+
+	labels, err := AppLabels(&b, "bytesized_rtorrent_1234", opts)
+	if err != nil {
+		t.Fatal("Could not create labels:", err)
+	}
+
+	if labels[LabelPlugin] != "rtorrent" || labels[LabelPluginVersion] != "2" {
+		t.Error("Labels do not describe the plugin:", labels)
+	}
+	if labels[LabelInstance] != "bytesized_rtorrent_1234" {
+		t.Error("Instance label not set:", labels)
+	}
+	if strings.Contains(labels[LabelOptions], "secret") {
+		t.Error("Password leaked into the options label:", labels[LabelOptions])
+	}
+	if strings.Contains(labels[LabelOptions], "jan") {
+		t.Error("User ended up in the options label:", labels[LabelOptions])
+	}
+	if !strings.Contains(labels[LabelOptions], "4321") {
+		t.Error("Options label misses the dht port:", labels[LabelOptions])
+	}
+
+	// dht_port stands in for an option a generic manifest declares secret.
+	b.Manifest = &Manifest{MethodOptions: map[string][]MethodOption{"Install": {{Name: "dht_port", Type: TypeSecret}}}}
+	labels, _ = AppLabels(&b, "bytesized_rtorrent_1234", opts)
+	if strings.Contains(labels[LabelOptions], "4321") {
+		t.Error("Option declared secret in the manifest leaked into the options label:", labels[LabelOptions])
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	log "github.com/Sirupsen/logrus"
 	"github.com/bytesizedhosting/bcd/core"
+	"github.com/bytesizedhosting/bcd/jobs"
 	"github.com/fsouza/go-dockerclient"
 	"github.com/ghodss/yaml"
 	"io/ioutil"
@@ -14,43 +15,129 @@ import (
 	"os/user"
 	"path"
 	"text/template"
+	"time"
 )
 
 type Manifest struct {
-	Version        float32                   `json:"version"`
-	ExposedMethods []string                  `json:"exposed_methods"`
-	MethodOptions  map[string][]MethodOption `json:"method_options",yaml: "method_options,flow"`
-	ShowOptions    []string                  `json:"show_options"`
-	Name           string                    `json:"name"`
-	RpcName        string                    `json:"rpc_name"`
-	WebUrlFormat   string                    `json:"web_url_format"`
-	Description    string                    `json:"description"`
+	Version        float32  `json:"version"`
+	ExposedMethods []string `json:"exposed_methods"`
+	// Methods every app has but this one can not do, clients should not
+	// offer them.
+	UnsupportedMethods []string                  `json:"unsupported_methods,omitempty"`
+	MethodOptions      map[string][]MethodOption `json:"method_options"`
+	ShowOptions        []string                  `json:"show_options"`
+	Name               string                    `json:"name"`
+	RpcName            string                    `json:"rpc_name"`
+	WebUrlFormat       string                    `json:"web_url_format"`
+	Description        string                    `json:"description"`
+
+	// Apps without Go code of their own are installed by the generic plugin
+	// using the fields below, see plugins/generic.
+	Image        string         `json:"image,omitempty"`
+	Ports        []PortOption   `json:"ports,omitempty"`
+	Binds        []string       `json:"binds,omitempty"`
+	Env          []string       `json:"env,omitempty"`
+	Templates    []TemplateFile `json:"templates,omitempty"`
+	PasswordHash string         `json:"password_hash,omitempty"`
+
+	// Limits apps get unless the install options set their own.
+	Resources *Resources `json:"resources,omitempty"`
+	// Probes that tell whether the app works, see HealthMonitor.
+	Health []HealthProbe `json:"health,omitempty"`
+}
+
+// PortOption publishes a container port on the host port held by an option.
+type PortOption struct {
+	Container string `json:"container"`
+	Option    string `json:"option"`
+}
+
+// TemplateFile is a template on disk, relative to the manifest, and the path
+// it is written to. The destination is a template as well.
+type TemplateFile struct {
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+	// The lines holding the password, by the text they start with, see
+	// RewriteTemplateLines. ChangePassword rewrites only these.
+	Credentials []string `json:"credentials,omitempty"`
 }
 
 type MethodOption struct {
 	Name          string `json:"name"`
-	DefaultValue  string `json:"default_value", yaml:"default_value"`
+	DefaultValue  string `json:"default_value"`
 	Type          string `json:"type"`
 	Hint          string `json:"hint"`
 	AllowDeletion bool   `json:"allow_deletion"`
+
+	// Constraints checked by Validate. Min and Max bound the value of int and
+	// port options and the length of the others, Values lists the choices of
+	// an enum.
+	Required bool     `json:"required,omitempty"`
+	Min      *int     `json:"min,omitempty"`
+	Max      *int     `json:"max,omitempty"`
+	Pattern  string   `json:"pattern,omitempty"`
+	Values   []string `json:"values,omitempty"`
 }
 type BaseOpts struct {
-	ContainerId  string     `json:"container_id,omitempty"`
-	Password     string     `json:"password,omitempty"`
-	WebPort      string     `json:"web_port,omitempty"`
-	RunAsUser    string     `json:"run_as_user,omitempty"`
-	Username     string     `json:"username,omitempty"`
-	ConfigFolder string     `json:"config_folder,omitempty"`
-	DataFolder   string     `json:"data_folder,omitempty"`
-	MediaFolder  string     `json:"media_folder,omitempty"`
-	NoTemplates  string     `json:"no_templates"`
-	User         *user.User `json:"user,omitempty"`
+	ContainerId  string `json:"container_id,omitempty"`
+	Password     string `json:"password,omitempty"`
+	WebPort      string `json:"web_port,omitempty"`
+	RunAsUser    string `json:"run_as_user,omitempty"`
+	Username     string `json:"username,omitempty"`
+	ConfigFolder string `json:"config_folder,omitempty"`
+	DataFolder   string `json:"data_folder,omitempty"`
+	MediaFolder  string `json:"media_folder,omitempty"`
+	NoTemplates  string `json:"no_templates"`
+	CallbackUrl  string `json:"callback_url,omitempty"`
+	UpdatePolicy string `json:"update_policy,omitempty"`
+	// See DockerRestartPolicy.
+	RestartPolicy     string     `json:"restart_policy,omitempty"`
+	RestartMaxRetries string     `json:"restart_max_retries,omitempty"`
+	User              *user.User `json:"user,omitempty"`
+	Resources
+	job *jobs.Job
 }
 
 func (self *BaseOpts) GetBaseOpts() BaseOpts {
 	return *self
 }
+
+// SetJob attaches the job the options are installed by, the helpers on Base
+// report their progress to it.
+func (self *BaseOpts) SetJob(job *jobs.Job) {
+	self.job = job
+}
+
+// Job returns the attached job, this can be nil.
+func (self BaseOpts) Job() *jobs.Job {
+	return self.job
+}
+
+// FreePort claims a free port for the install, the claim is released again
+// when the install is rolled back.
+func (self *BaseOpts) FreePort() (string, error) {
+	port, err := core.GetFreePort()
+	if err != nil {
+		return "", err
+	}
+
+	self.job.OnRollback("claiming port "+port, func() error {
+		core.ReleasePort(port)
+		return nil
+	})
+	return port, nil
+}
+
 func (opts *BaseOpts) SetDefault(name string) error {
+	opts.job.Stage(jobs.StageFolders)
+
+	if opts.UpdatePolicy != "" && !updatePolicies[opts.UpdatePolicy] {
+		return fmt.Errorf("Unknown update_policy '%s', use never, notify or auto", opts.UpdatePolicy)
+	}
+	if _, err := opts.DockerRestartPolicy(); err != nil {
+		return err
+	}
+
 	if opts.RunAsUser == "" {
 		log.Debugln("No run_as_user received, using default 'bytesized'")
 		opts.RunAsUser = "bytesized"
@@ -93,7 +180,7 @@ func (opts *BaseOpts) SetDefault(name string) error {
 	}
 
 	if opts.WebPort == "" {
-		p, err := core.GetFreePort()
+		p, err := opts.FreePort()
 		if err != nil {
 			return err
 		}
@@ -113,9 +200,13 @@ type appPlugin interface {
 	Plugin
 	Start(*AppConfig) error
 	Stop(*AppConfig) error
-	Status(*AppConfig) (*docker.State, error)
+	Status(*AppConfig) (*AppStatus, error)
 	Restart(*AppConfig) error
 	Uninstall(*AppConfig) error
+	Upgrade(string, time.Duration, *jobs.Job) (*UpgradeResult, error)
+	UpdateResources(string, Resources) error
+	Logs(LogsOpts) (*LogsResult, error)
+	ChangePassword(string, string) error
 }
 
 func DumpManifest(manifest *Manifest) {
@@ -127,9 +218,11 @@ func DumpManifest(manifest *Manifest) {
 	}
 }
 
+// LoadManifest returns the built-in manifest of a plugin with the custom one
+// from the manifests folder merged on top, see OverlayManifest. A custom
+// manifest is used as is when there is no built-in one.
 func LoadManifest(name string) (*Manifest, error) {
-	manifestLoaded := false
-	manifest := Manifest{}
+	var custom []byte
 
 	configPath, err := core.ConfigPath()
 	if err != nil {
@@ -141,41 +234,62 @@ func LoadManifest(name string) (*Manifest, error) {
 	if _, err := os.Stat(manifestPath); err == nil {
 		log.Debugln("Custom manifest found. Loading from path: ", manifestPath)
 
-		data, err := ioutil.ReadFile(manifestPath)
-		if err != nil {
-			log.Debugln("Could not load custom manifest: ", err)
-		}
-		err = yaml.Unmarshal(data, &manifest)
+		custom, err = ioutil.ReadFile(manifestPath)
 		if err != nil {
-			log.Debugln("Could not Ummarshal YAML data. Probably broken manifest.", err)
-		}
-		if err == nil {
-			manifestLoaded = true
+			log.Warnln("Could not load custom manifest: ", err)
 		}
 	}
 
-	if manifestLoaded == false {
-		log.Debugf("No custom manifest could be loaded for %s, using build-in one.", name)
-		data, err := Asset("plugins/" + name + "/data/manifest.yml")
-		if err != nil {
+	builtin, err := Asset("plugins/" + name + "/data/manifest.yml")
+	if err != nil {
+		if custom == nil {
 			return nil, err
 		}
-		err = yaml.Unmarshal(data, &manifest)
+		manifest := Manifest{}
+		err = yaml.Unmarshal(custom, &manifest)
 		if err != nil {
 			return nil, err
 		}
+		return &manifest, nil
+	}
+
+	if custom != nil {
+		manifest, err := OverlayManifest(builtin, custom)
+		if err == nil {
+			return manifest, nil
+		}
+		log.Warnf("Could not merge the custom manifest of %s, using the build-in one. Probably broken manifest: %s", name, err)
 	}
 
+	manifest := Manifest{}
+	err = yaml.Unmarshal(builtin, &manifest)
+	if err != nil {
+		return nil, err
+	}
 	return &manifest, nil
 }
 
-func (self *Base) Status(opts *AppConfig) (*docker.State, error) {
+// AppStatus is the state of a container together with how it is restarted.
+type AppStatus struct {
+	docker.State
+	RestartPolicy docker.RestartPolicy `json:"RestartPolicy"`
+	RestartCount  int                  `json:"RestartCount"`
+	CrashLoop     *CrashLoop           `json:"CrashLoop,omitempty"`
+	Health        *Health              `json:"Health"`
+}
+
+func (self *Base) Status(opts *AppConfig) (*AppStatus, error) {
 	container, err := self.DockerClient.InspectContainer(opts.ContainerId)
 	if err != nil {
 		return nil, err
 	}
 
-	return &container.State, nil
+	status := &AppStatus{State: container.State, RestartCount: container.RestartCount, CrashLoop: Supervise.CrashLoop(container.ID),
+		Health: Probes.Status(self, container)}
+	if container.HostConfig != nil {
+		status.RestartPolicy = container.HostConfig.RestartPolicy
+	}
+	return status, nil
 }
 
 func (self *Base) Stop(opts *AppConfig) error {
@@ -203,6 +317,12 @@ func (self *Base) Start(opts *AppConfig) error {
 		return fmt.Errorf("Could not find container to start with id '%s'", opts.ContainerId)
 	}
 
+	// Starting an app by hand gives it another chance after a crash loop.
+	err = Supervise.restore(self.DockerClient, opts.ContainerId)
+	if err != nil {
+		log.Warnf("Could not restore the restart policy of %s: %s", opts.ContainerId, err)
+	}
+
 	err = self.DockerClient.StartContainer(opts.ContainerId, nil)
 	if err != nil {
 		return err
@@ -276,16 +396,40 @@ func (s *Base) WriteTemplate(templateName string, outputFile string, object Opti
 		return nil
 	}
 
+	data, err := Asset(templateName)
+	if err != nil {
+		return err
+	}
+
+	return s.writeTemplate(templateName, data, outputFile, object)
+}
+
+// WriteTemplateFile works like WriteTemplate but reads the template from disk
+// instead of the embedded assets.
+func (s *Base) WriteTemplateFile(templatePath string, outputFile string, object Options) error {
+	if object.GetBaseOpts().NoTemplates == "true" {
+		log.Debugln("We are not (re-)creating templates since no_templates has been set to true, moving on.")
+		return nil
+	}
+
+	data, err := ioutil.ReadFile(templatePath)
+	if err != nil {
+		return err
+	}
+
+	return s.writeTemplate(templatePath, data, outputFile, object)
+}
+
+func (s *Base) writeTemplate(templateName string, data []byte, outputFile string, object Options) error {
 	log.WithFields(log.Fields{
 		"plugin":       s.Name,
 		"templateName": templateName,
 		"outputFile":   outputFile,
 	}).Debug("Writing template")
 
-	data, err := Asset(templateName)
-	if err != nil {
-		return err
-	}
+	job := object.GetBaseOpts().Job()
+	job.Stage(jobs.StageTemplates)
+	job.Logf("Writing %s", outputFile)
 
 	tmpl, err := template.New(templateName).Parse(string(data[:]))
 
@@ -295,16 +439,26 @@ func (s *Base) WriteTemplate(templateName string, outputFile string, object Opti
 
 	if _, err := os.Stat(outputFile); err == nil {
 		log.Debugf("File %s already exists, renaming it for back-up purposes.", outputFile)
-		err := os.Rename(outputFile, fmt.Sprintf("%s.backup", outputFile))
+		backupFile := fmt.Sprintf("%s.backup", outputFile)
+		err := os.Rename(outputFile, backupFile)
 		if err != nil {
 			log.Warningln("Could not rename file, trying to create the new file anyway. Error:", err)
+		} else {
+			job.OnRollback("writing "+outputFile, func() error {
+				return os.Rename(backupFile, outputFile)
+			})
 		}
+	} else {
+		job.OnRollback("writing "+outputFile, func() error {
+			return os.Remove(outputFile)
+		})
 	}
 
 	file, err := os.Create(outputFile)
 	if err != nil {
 		return err
 	}
+	defer file.Close()
 
 	err = tmpl.Execute(file, object)
 	if err != nil {
@@ -314,6 +468,62 @@ func (s *Base) WriteTemplate(templateName string, outputFile string, object Opti
 	return nil
 }
 
+// CreateContainer creates the app container and labels it with the plugin and
+// the options it was installed with.
+func (self *Base) CreateContainer(opts Options, createOpts docker.CreateContainerOptions) (*docker.Container, error) {
+	labels, err := AppLabels(self, createOpts.Name, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if createOpts.Config.Labels == nil {
+		createOpts.Config.Labels = map[string]string{}
+	}
+	for key, value := range labels {
+		createOpts.Config.Labels[key] = value
+	}
+
+	var defaults *Resources
+	if self.Manifest != nil {
+		defaults = self.Manifest.Resources
+	}
+	if createOpts.HostConfig == nil {
+		createOpts.HostConfig = &docker.HostConfig{}
+	}
+	base := opts.GetBaseOpts()
+	err = base.Resources.WithDefaults(defaults).Apply(createOpts.HostConfig)
+	if err != nil {
+		return nil, err
+	}
+	createOpts.HostConfig.RestartPolicy, err = base.DockerRestartPolicy()
+	if err != nil {
+		return nil, err
+	}
+	// Kept on the container so a crash looping app gets its policy back, even
+	// after bcd restarted.
+	createOpts.Config.Labels[LabelRestartPolicy] = RestartPolicyLabel(createOpts.HostConfig.RestartPolicy)
+
+	job := opts.GetBaseOpts().Job()
+	job.Stage(jobs.StageCreate)
+
+	createOpts.Context = job.Context()
+	container, err := self.DockerClient.CreateContainer(createOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	job.OnRollback("creating container "+createOpts.Name, func() error {
+		return self.DockerClient.RemoveContainer(docker.RemoveContainerOptions{ID: container.ID, Force: true})
+	})
+
+	return container, nil
+}
+
+func (self *Base) StartContainer(id string, opts Options) error {
+	opts.GetBaseOpts().Job().Stage(jobs.StageStart)
+	return self.DockerClient.StartContainer(id, nil)
+}
+
 func (self *Base) RegisterRPC(server *rpc.Server) {
 	server.Register(self)
 }
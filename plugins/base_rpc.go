@@ -1,9 +1,11 @@
 package plugins
 
 import (
+	"fmt"
 	log "github.com/Sirupsen/logrus"
-	"github.com/fsouza/go-dockerclient"
+	"github.com/bytesizedhosting/bcd/jobs"
 	"os"
+	"time"
 )
 
 func NewBaseRPC(parent appPlugin) *BaseRPC {
@@ -19,6 +21,96 @@ type ActionOpts struct {
 	DeleteFolders []string `json:"delete_folders"`
 }
 
+// UpgradeOpts selects the container to upgrade. HealthTimeout is in seconds
+// and defaults to DefaultHealthTimeout.
+type UpgradeOpts struct {
+	ContainerId   string `json:"container_id"`
+	CallbackUrl   string `json:"callback_url,omitempty"`
+	HealthTimeout int    `json:"health_timeout,omitempty"`
+}
+
+// Upgrade pulls the latest image of an app and recreates its container from
+// it. It returns a job right away, the job rolls back to the old image when
+// the upgraded app does not become healthy.
+func (self *BaseRPC) Upgrade(opts *UpgradeOpts, job *jobs.Job) error {
+	*job = startUpgrade(self.base, *opts).Snapshot()
+	return nil
+}
+
+func startUpgrade(p appPlugin, opts UpgradeOpts) *jobs.Job {
+	running := jobs.New(p.GetName(), opts, opts.CallbackUrl)
+
+	log.WithFields(log.Fields{
+		"container_id": opts.ContainerId,
+		"name":         p.GetName(),
+	}).Info("Upgrading container")
+
+	jobs.Queue.Run(running, func() {
+		res, err := p.Upgrade(opts.ContainerId, time.Duration(opts.HealthTimeout)*time.Second, running)
+		if err != nil {
+			log.Debugln(p.GetName(), "upgrade received an error:", err)
+			running.Fail(err)
+		} else {
+			log.Infoln(p.GetName(), "upgrade completed")
+			running.Succeed(*res)
+		}
+	})
+
+	return running
+}
+
+// ResourceOpts selects the container to change the limits of.
+type ResourceOpts struct {
+	ContainerId string `json:"container_id"`
+	Resources
+}
+
+// UpdateResources changes the resource limits of a running app without
+// recreating its container.
+func (self *BaseRPC) UpdateResources(opts *ResourceOpts, success *bool) error {
+	log.WithFields(log.Fields{
+		"container_id": opts.ContainerId,
+		"name":         self.base.GetName(),
+	}).Info("Updating resource limits")
+
+	err := self.base.UpdateResources(opts.ContainerId, opts.Resources)
+	if err != nil {
+		return err
+	}
+	*success = true
+	return nil
+}
+
+// Logs returns the output of an app, see LogsOpts for following it.
+func (self *BaseRPC) Logs(opts *LogsOpts, res *LogsResult) error {
+	logs, err := self.base.Logs(*opts)
+	if err != nil {
+		return err
+	}
+	*res = *logs
+	return nil
+}
+
+// ChangePassword sets a new password on an installed app and restarts it.
+// Only the files holding the credentials are rewritten.
+func (self *BaseRPC) ChangePassword(opts *PasswordOpts, success *bool) error {
+	if opts.Password == "" {
+		return fmt.Errorf("The new password can not be empty")
+	}
+
+	log.WithFields(log.Fields{
+		"container_id": opts.ContainerId,
+		"name":         self.base.GetName(),
+	}).Info("Changing password")
+
+	err := self.base.ChangePassword(opts.ContainerId, opts.Password)
+	if err != nil {
+		return err
+	}
+	*success = true
+	return nil
+}
+
 func (self *BaseRPC) Start(opts *ActionOpts, success *bool) error {
 	containerId := opts.ContainerId
 	log.WithFields(log.Fields{
@@ -41,7 +133,7 @@ func (self *BaseRPC) Start(opts *ActionOpts, success *bool) error {
 	return nil
 }
 
-func (self *BaseRPC) Status(opts *ActionOpts, state *docker.State) error {
+func (self *BaseRPC) Status(opts *ActionOpts, state *AppStatus) error {
 	containerId := opts.ContainerId
 	s, err := self.base.Status(&AppConfig{ContainerId: containerId})
 	if err != nil {
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"io/ioutil"
 	"log"
+	"net/http"
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
 )
 
@@ -60,3 +62,48 @@ type TestOpts struct {
 	DhtPort      string `json:"dht_port,omitempty"`
 	DataFolder   string `json:"data_folder,omitempty"`
 }
+
+// fakeDocker answers Docker API calls with the JSON reply listed for
+// "METHOD path", an empty reply means no content. Every call is recorded with
+// its name parameter, if any.
+type fakeDocker struct {
+	lock    sync.Mutex
+	replies map[string]string
+	calls   []string
+}
+
+func (self *fakeDocker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	call := r.Method + " " + r.URL.Path
+	reply, ok := self.replies[call]
+	if name := r.URL.Query().Get("name"); name != "" {
+		call += "?name=" + name
+	}
+
+	self.lock.Lock()
+	self.calls = append(self.calls, call)
+	self.lock.Unlock()
+
+	switch {
+	case !ok:
+		w.WriteHeader(404)
+	case reply == "":
+		w.WriteHeader(204)
+	default:
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(reply))
+	}
+}
+
+// called tells whether the calls were made in this order.
+func (self *fakeDocker) called(calls ...string) bool {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	i := 0
+	for _, call := range self.calls {
+		if i < len(calls) && call == calls[i] {
+			i++
+		}
+	}
+	return i == len(calls)
+}
@@ -13,6 +13,16 @@ type Cardigann struct {
 	imageName string
 }
 
+func init() {
+	plugins.Register("cardigann", func(client *docker.Client) (plugins.Plugin, error) {
+		p, err := New(client)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
 func New(client *docker.Client) (*Cardigann, error) {
 	manifest, err := plugins.LoadManifest("cardigann")
 
@@ -59,7 +69,7 @@ func (self *Cardigann) Install(opts *CardigannOpts) error {
 	}
 
 	log.Debugln("Pulling docker image", self.imageName)
-	err = self.DockerClient.PullImage(docker.PullImageOptions{Repository: self.imageName}, docker.AuthConfiguration{})
+	err = self.PullImage(self.imageName, opts)
 	if err != nil {
 		return err
 	}
@@ -78,7 +88,7 @@ func (self *Cardigann) Install(opts *CardigannOpts) error {
 	conf := docker.Config{Env: []string{"PUID=" + opts.User.Uid}, Image: self.imageName}
 
 	log.Debugln("Creating docker container")
-	c, err := self.DockerClient.CreateContainer(docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_cardigann_" + opts.WebPort})
+	c, err := self.CreateContainer(opts, docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_cardigann_" + opts.WebPort})
 
 	if err != nil {
 		return err
@@ -86,7 +96,7 @@ func (self *Cardigann) Install(opts *CardigannOpts) error {
 
 	log.Debugln("Starting docker container")
 
-	err = self.DockerClient.StartContainer(c.ID, nil)
+	err = self.StartContainer(c.ID, opts)
 	if err != nil {
 		return err
 	}
@@ -95,3 +105,21 @@ func (self *Cardigann) Install(opts *CardigannOpts) error {
 
 	return nil
 }
+
+// ChangePassword sets the passphrase of the web interface.
+func (self *Cardigann) ChangePassword(containerId string, password string) error {
+	opts := &CardigannOpts{}
+	_, err := self.LoadOptions(containerId, opts)
+	if err != nil {
+		return err
+	}
+
+	opts.Password = password
+
+	err = self.RewriteTemplateLines("plugins/cardigann/data/config.json", opts.ConfigFolder+"/config.json", opts, `"passphrase":`)
+	if err != nil {
+		return err
+	}
+
+	return self.Restart(&plugins.AppConfig{ContainerId: containerId})
+}
@@ -22,24 +22,21 @@ func (self *CardigannRPC) Reinstall(opts *CardigannOpts, job *jobs.Job) error {
 }
 
 func (self *CardigannRPC) Install(opts *CardigannOpts, job *jobs.Job) error {
-	*job = *jobs.New(opts)
+	running := jobs.New(self.base.GetName(), *opts, opts.CallbackUrl)
+	opts.SetJob(running)
+	*job = running.Snapshot()
+
 	log.Debugln("Cardigann options:", opts)
-	go func() {
+	jobs.Queue.Run(running, func() {
 		err := self.base.Install(opts)
-		job.Options = *opts
-
 		if err != nil {
 			log.Debugln("Cardigann installation received an error:", err)
-			job.ErrorString = err.Error()
-			job.Error = err
-			job.Status = jobs.FAILED
+			running.Fail(err)
 		} else {
 			log.Infoln("Cardigann installation completed")
-			job.Status = jobs.FINISHED
+			running.Succeed(*opts)
 		}
-
-		jobs.Storage.Set(job.Id, job)
-	}()
+	})
 
 	return nil
 }
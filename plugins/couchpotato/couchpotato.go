@@ -15,6 +15,16 @@ type Couchpotato struct {
 	imageName string
 }
 
+func init() {
+	plugins.Register("couchpotato", func(client *docker.Client) (plugins.Plugin, error) {
+		p, err := New(client)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
 func New(client *docker.Client) (*Couchpotato, error) {
 	manifest, err := plugins.LoadManifest("couchpotato")
 
@@ -71,7 +81,7 @@ func (self *Couchpotato) Install(opts *CouchpotatoOpts) error {
 	}
 
 	log.Debugln("Pulling docker image", self.imageName)
-	err = self.DockerClient.PullImage(docker.PullImageOptions{Repository: self.imageName}, docker.AuthConfiguration{})
+	err = self.PullImage(self.imageName, opts)
 	if err != nil {
 		return err
 	}
@@ -88,7 +98,7 @@ func (self *Couchpotato) Install(opts *CouchpotatoOpts) error {
 	conf := docker.Config{Env: []string{"PUID=" + opts.User.Uid}, Image: self.imageName}
 
 	log.Debugln("Creating docker container")
-	c, err := self.DockerClient.CreateContainer(docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_couchpotato_" + opts.WebPort})
+	c, err := self.CreateContainer(opts, docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_couchpotato_" + opts.WebPort})
 
 	if err != nil {
 		return err
@@ -96,7 +106,7 @@ func (self *Couchpotato) Install(opts *CouchpotatoOpts) error {
 
 	log.Debugln("Starting docker container")
 
-	err = self.DockerClient.StartContainer(c.ID, nil)
+	err = self.StartContainer(c.ID, opts)
 	if err != nil {
 		return err
 	}
@@ -105,3 +115,23 @@ func (self *Couchpotato) Install(opts *CouchpotatoOpts) error {
 
 	return nil
 }
+
+// ChangePassword rewrites the password of the core section, the passwords of the
+// downloaders further down are left alone.
+func (self *Couchpotato) ChangePassword(containerId string, password string) error {
+	opts := &CouchpotatoOpts{}
+	_, err := self.LoadOptions(containerId, opts)
+	if err != nil {
+		return err
+	}
+
+	opts.Password = password
+	opts.hashPassword()
+
+	err = self.RewriteTemplateLines("plugins/couchpotato/data/settings.conf", opts.ConfigFolder+"/settings.conf", opts, "[core]password =")
+	if err != nil {
+		return err
+	}
+
+	return self.Restart(&plugins.AppConfig{ContainerId: containerId})
+}
@@ -13,6 +13,16 @@ import (
 
 const imageName = "bytesized/deluge"
 
+func init() {
+	plugins.Register("deluge", func(client *docker.Client) (plugins.Plugin, error) {
+		p, err := New(client)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
 func New(client *docker.Client) (*Deluge, error) {
 	manifest, err := plugins.LoadManifest("deluge")
 	if err != nil {
@@ -56,7 +66,7 @@ func (self *Deluge) Install(opts *DelugeOpts) error {
 	}
 
 	for i := 0; i < 4; i++ {
-		p, err := core.GetFreePort()
+		p, err := opts.FreePort()
 		if err != nil {
 			return err
 		}
@@ -108,7 +118,7 @@ func (self *Deluge) Install(opts *DelugeOpts) error {
 	}
 
 	log.Debugln("Pulling docker image", imageName)
-	err = self.DockerClient.PullImage(docker.PullImageOptions{Repository: imageName}, docker.AuthConfiguration{})
+	err = self.PullImage(imageName, opts)
 	if err != nil {
 		return err
 	}
@@ -121,14 +131,14 @@ func (self *Deluge) Install(opts *DelugeOpts) error {
 	}
 
 	log.Debugln("Creating docker container")
-	c, err := self.DockerClient.CreateContainer(docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_deluge_" + opts.WebPort})
+	c, err := self.CreateContainer(opts, docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_deluge_" + opts.WebPort})
 
 	if err != nil {
 		return err
 	}
 
 	log.Debugln("Starting docker container")
-	err = self.DockerClient.StartContainer(c.ID, nil)
+	err = self.StartContainer(c.ID, opts)
 	if err != nil {
 		return err
 	}
@@ -145,3 +155,29 @@ func (self *DelugeOpts) hashPassword() string {
 	self.EncPassword = fmt.Sprintf("%x", sha.Sum(nil))
 	return self.EncPassword
 }
+
+// ChangePassword sets the password of the web interface and of the thin
+// client user. The localclient entry is left alone.
+func (self *Deluge) ChangePassword(containerId string, password string) error {
+	opts := &DelugeOpts{}
+	_, err := self.LoadOptions(containerId, opts)
+	if err != nil {
+		return err
+	}
+
+	opts.Password = password
+	opts.Salt = fmt.Sprintf("%x", core.GetRandom(20))
+	opts.hashPassword()
+
+	err = self.RewriteTemplateLines("plugins/deluge/data/web.conf", opts.ConfigFolder+"/web.conf", opts, `"pwd_sha1":`, `"pwd_salt":`)
+	if err != nil {
+		return err
+	}
+
+	err = self.RewriteTemplateLines("plugins/deluge/data/auth", opts.ConfigFolder+"/auth", opts, opts.Username+":")
+	if err != nil {
+		return err
+	}
+
+	return self.Restart(&plugins.AppConfig{ContainerId: containerId})
+}
@@ -0,0 +1,190 @@
+package plugins
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// DefaultRegistry is asked about images that do not name a registry.
+const DefaultRegistry = "https://registry-1.docker.io"
+
+// Manifest types we accept, asking for the lists too makes the registry
+// return the same digest Docker recorded when it pulled the tag.
+var manifestTypes = []string{
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.oci.image.manifest.v1+json",
+}
+
+// Names Docker Hub goes by, images on it are looked up on the default
+// registry.
+var dockerHubHosts = map[string]bool{"docker.io": true, "index.docker.io": true, "registry-1.docker.io": true}
+
+var challengePattern = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// ImageRef is an image name split into its parts, Host is empty for images
+// on the default registry.
+type ImageRef struct {
+	Host       string
+	Repository string
+	Tag        string
+}
+
+// ParseImage splits an image name like "linuxserver/sonarr:develop". Images
+// pinned to a digest can not become stale and are refused.
+func ParseImage(name string) (*ImageRef, error) {
+	if strings.Contains(name, "@") {
+		return nil, fmt.Errorf("Image %s is pinned to a digest", name)
+	}
+
+	ref := &ImageRef{Tag: "latest"}
+	parts := strings.SplitN(name, "/", 2)
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		ref.Host = parts[0]
+		name = parts[1]
+	}
+	if dockerHubHosts[ref.Host] {
+		ref.Host = ""
+	}
+
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		ref.Tag = name[i+1:]
+		name = name[:i]
+	}
+	if ref.Host == "" && !strings.Contains(name, "/") {
+		name = "library/" + name
+	}
+	ref.Repository = name
+
+	if ref.Repository == "" || ref.Tag == "" {
+		return nil, fmt.Errorf("Invalid image name '%s'", name)
+	}
+	return ref, nil
+}
+
+// RegistryClient looks up the current digest of image tags with the v2
+// registry API.
+type RegistryClient struct {
+	// Used for images without a registry host, DefaultRegistry when empty.
+	DefaultUrl string
+	client     *http.Client
+}
+
+func NewRegistryClient(defaultUrl string) *RegistryClient {
+	if defaultUrl == "" {
+		defaultUrl = DefaultRegistry
+	}
+	return &RegistryClient{DefaultUrl: strings.TrimSuffix(defaultUrl, "/"), client: &http.Client{Timeout: 30 * time.Second}}
+}
+
+// Digest returns the digest the registry currently serves for the image.
+func (self *RegistryClient) Digest(image string) (string, error) {
+	ref, err := ParseImage(image)
+	if err != nil {
+		return "", err
+	}
+
+	base := self.DefaultUrl
+	if ref.Host != "" {
+		// Like Docker, only local registries are spoken to without TLS.
+		base = "https://" + ref.Host
+		if strings.HasPrefix(ref.Host, "localhost") || strings.HasPrefix(ref.Host, "127.") {
+			base = "http://" + ref.Host
+		}
+	}
+	manifestUrl := fmt.Sprintf("%s/v2/%s/manifests/%s", base, ref.Repository, ref.Tag)
+
+	res, err := self.head(manifestUrl, "")
+	if err != nil {
+		return "", err
+	}
+
+	if res.StatusCode == http.StatusUnauthorized {
+		token, err := self.token(res.Header.Get("WWW-Authenticate"))
+		if err != nil {
+			return "", err
+		}
+		res, err = self.head(manifestUrl, token)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Registry answered %s for %s", res.Status, image)
+	}
+	digest := res.Header.Get("Docker-Content-Digest")
+	if digest == "" {
+		return "", fmt.Errorf("Registry sent no digest for %s", image)
+	}
+	return digest, nil
+}
+
+func (self *RegistryClient) head(manifestUrl string, token string) (*http.Response, error) {
+	req, err := http.NewRequest("HEAD", manifestUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", strings.Join(manifestTypes, ", "))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	res, err := self.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	res.Body.Close()
+	return res, nil
+}
+
+// token answers a bearer challenge with an anonymous token, that is all
+// public images need.
+func (self *RegistryClient) token(challenge string) (string, error) {
+	if !strings.HasPrefix(challenge, "Bearer ") {
+		return "", fmt.Errorf("Registry wants unsupported authentication '%s'", challenge)
+	}
+
+	params := map[string]string{}
+	for _, match := range challengePattern.FindAllStringSubmatch(challenge, -1) {
+		params[match[1]] = match[2]
+	}
+	if params["realm"] == "" {
+		return "", fmt.Errorf("Registry sent a challenge without realm")
+	}
+
+	query := url.Values{}
+	for _, key := range []string{"service", "scope"} {
+		if params[key] != "" {
+			query.Set(key, params[key])
+		}
+	}
+
+	res, err := self.client.Get(params["realm"] + "?" + query.Encode())
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Could not get a registry token: %s", res.Status)
+	}
+
+	body := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	err = json.NewDecoder(res.Body).Decode(&body)
+	if err != nil {
+		return "", err
+	}
+	if body.Token == "" {
+		body.Token = body.AccessToken
+	}
+	return body.Token, nil
+}
@@ -0,0 +1,46 @@
+package plugins
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRegistryDigest(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/token":
+			if r.URL.Query().Get("scope") != "repository:library/sonarr:pull" {
+				w.WriteHeader(400)
+				return
+			}
+			w.Write([]byte(`{"token": "secret"}`))
+		case r.Header.Get("Authorization") != "Bearer secret":
+			w.Header().Set("WWW-Authenticate", `Bearer realm="`+server.URL+`/token",service="test",scope="repository:library/sonarr:pull"`)
+			w.WriteHeader(401)
+		case r.Method == "HEAD" && r.URL.Path == "/v2/library/sonarr/manifests/develop":
+			w.Header().Set("Docker-Content-Digest", "sha256:abc")
+		default:
+			w.WriteHeader(404)
+		}
+	}))
+	defer server.Close()
+
+	registry := NewRegistryClient(server.URL)
+	digest, err := registry.Digest("docker.io/sonarr:develop")
+	if err != nil || digest != "sha256:abc" {
+		t.Errorf("Expected digest sha256:abc, got '%s': %v", digest, err)
+	}
+	if _, err := registry.Digest("sonarr:missing"); err == nil {
+		t.Error("Expected an error for a missing tag")
+	}
+
+	ref, _ := ParseImage("localhost:5000/team/app")
+	if ref.Host != "localhost:5000" || ref.Repository != "team/app" || ref.Tag != "latest" {
+		t.Errorf("Unexpected reference: %+v", ref)
+	}
+	if repoDigest([]string{"other@sha256:def", "sonarr@sha256:abc"}, &ImageRef{Repository: "library/sonarr"}) != "sha256:abc" {
+		t.Error("Expected the digest of the matching repository")
+	}
+}
@@ -14,6 +14,16 @@ type Filebot struct {
 	imageName string
 }
 
+func init() {
+	plugins.Register("filebot", func(client *docker.Client) (plugins.Plugin, error) {
+		p, err := New(client)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
 func New(client *docker.Client) (*Filebot, error) {
 	manifest, err := plugins.LoadManifest("filebot")
 
@@ -86,7 +96,7 @@ func (self *Filebot) Install(opts *FilebotOpts) error {
 	}).Debug("Plugin options")
 
 	log.Debugln("Pulling docker image", self.imageName)
-	err = self.DockerClient.PullImage(docker.PullImageOptions{Repository: self.imageName}, docker.AuthConfiguration{})
+	err = self.PullImage(self.imageName, opts)
 	if err != nil {
 		return err
 	}
@@ -98,7 +108,7 @@ func (self *Filebot) Install(opts *FilebotOpts) error {
 	conf := docker.Config{Env: []string{"PUID=" + opts.User.Uid}, Image: self.imageName}
 
 	log.Debugln("Creating docker container")
-	c, err := self.DockerClient.CreateContainer(docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_filebot_" + opts.WebPort})
+	c, err := self.CreateContainer(opts, docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_filebot_" + opts.WebPort})
 
 	if err != nil {
 		return err
@@ -106,7 +116,7 @@ func (self *Filebot) Install(opts *FilebotOpts) error {
 
 	log.Debugln("Starting docker container")
 
-	err = self.DockerClient.StartContainer(c.ID, nil)
+	err = self.StartContainer(c.ID, opts)
 	if err != nil {
 		return err
 	}
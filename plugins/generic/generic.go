@@ -0,0 +1,282 @@
+package generic
+
+import (
+	"bytes"
+	"fmt"
+	log "github.com/Sirupsen/logrus"
+	"github.com/bytesizedhosting/bcd/plugins"
+	"github.com/fsouza/go-dockerclient"
+	"github.com/ghodss/yaml"
+	"io/ioutil"
+	"net/rpc"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// Generic installs an app described by a manifest alone, no Go code needed.
+type Generic struct {
+	plugins.Base
+	folder string
+}
+
+// New creates the plugin for a manifest, template sources are looked up
+// relative to folder.
+func New(client *docker.Client, name string, manifest *plugins.Manifest, folder string) *Generic {
+	return &Generic{Base: plugins.Base{DockerClient: client, Name: name, Version: int(manifest.Version), Manifest: manifest}, folder: folder}
+}
+
+// RegisterManifests registers a plugin for every manifest in folder that
+// names an image. Manifests without one customise a built-in plugin and are
+// loaded by that plugin instead.
+func RegisterManifests(folder string) error {
+	files, err := filepath.Glob(path.Join(folder, "*.yml"))
+	if err != nil {
+		return err
+	}
+
+	registered := map[string]bool{}
+	for _, name := range plugins.Registered() {
+		registered[name] = true
+	}
+
+	for _, file := range files {
+		name, manifest, err := Load(file)
+		if err != nil {
+			log.Warnf("Could not load manifest %s: %s", file, err)
+			continue
+		}
+		if manifest.Image == "" {
+			continue
+		}
+		if registered[name] {
+			log.Warnf("Manifest %s names an image but a plugin called '%s' already exists, skipping it", file, name)
+			continue
+		}
+
+		log.Infof("Registering generic plugin %s for image %s", name, manifest.Image)
+		plugins.Register(name, func(client *docker.Client) (plugins.Plugin, error) {
+			return New(client, name, manifest, folder), nil
+		})
+		registered[name] = true
+	}
+
+	return nil
+}
+
+// Load reads a manifest file, the plugin name is the file name. The
+// rpc_name defaults to the plugin name followed by RPC.
+func Load(file string) (string, *plugins.Manifest, error) {
+	name := strings.ToLower(strings.TrimSuffix(path.Base(file), path.Ext(file)))
+
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return name, nil, err
+	}
+
+	manifest := plugins.Manifest{}
+	err = yaml.Unmarshal(data, &manifest)
+	if err != nil {
+		return name, nil, err
+	}
+
+	if manifest.PasswordHash != "" && hashers[manifest.PasswordHash] == nil {
+		return name, nil, fmt.Errorf("Unknown password hash '%s'", manifest.PasswordHash)
+	}
+	if manifest.RpcName == "" {
+		manifest.RpcName = strings.Title(name) + "RPC"
+	}
+	return name, &manifest, nil
+}
+
+func (self *Generic) RegisterRPC(server *rpc.Server) {
+	rpc := plugins.NewBaseRPC(self)
+	server.RegisterName(self.Manifest.RpcName, &GenericRPC{base: self, BaseRPC: *rpc})
+}
+
+func (self *Generic) Install(opts *GenericOpts) error {
+	var err error
+
+	err = opts.SetDefault(self.Name)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(log.Fields{
+		"plugin":        self.Name,
+		"image":         self.Manifest.Image,
+		"config_folder": opts.ConfigFolder,
+		"username":      opts.Username,
+	}).Debug("Generic plugin options")
+
+	err = os.MkdirAll(opts.ConfigFolder, 0755)
+	if err != nil {
+		return err
+	}
+
+	if self.Manifest.PasswordHash != "" {
+		err = opts.hashPassword(self.Manifest.PasswordHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	portBindings := map[docker.Port][]docker.PortBinding{}
+	for _, port := range self.Manifest.Ports {
+		hostPort, err := opts.port(port.Option)
+		if err != nil {
+			return err
+		}
+		portBindings[docker.Port(port.Container)] = []docker.PortBinding{docker.PortBinding{HostPort: hostPort}}
+	}
+
+	binds := plugins.DefaultBindings(opts)
+	for _, bind := range self.Manifest.Binds {
+		rendered, err := render(bind, opts)
+		if err != nil {
+			return err
+		}
+		binds = append(binds, rendered)
+	}
+
+	env := []string{"PUID=" + opts.User.Uid}
+	for _, variable := range self.Manifest.Env {
+		rendered, err := render(variable, opts)
+		if err != nil {
+			return err
+		}
+		env = append(env, rendered)
+	}
+
+	for _, file := range self.Manifest.Templates {
+		destination, err := render(file.Destination, opts)
+		if err != nil {
+			return err
+		}
+		err = self.WriteTemplateFile(path.Join(self.folder, file.Source), destination, opts)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Debugln("Pulling docker image", self.Manifest.Image)
+	err = self.PullImage(self.Manifest.Image, opts)
+	if err != nil {
+		return err
+	}
+
+	hostConfig := docker.HostConfig{
+		PortBindings: portBindings,
+		Binds:        binds,
+	}
+
+	conf := docker.Config{Env: env, Image: self.Manifest.Image}
+
+	log.Debugln("Creating docker container")
+	c, err := self.CreateContainer(opts, docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_" + self.Name + "_" + opts.WebPort})
+	if err != nil {
+		return err
+	}
+
+	log.Debugln("Starting docker container")
+	err = self.StartContainer(c.ID, opts)
+	if err != nil {
+		return err
+	}
+
+	opts.ContainerId = c.ID
+
+	return nil
+}
+
+// ChangePassword rewrites the credential lines the templates of the manifest
+// list, the rest of the files is left alone. Apps whose manifest lists none
+// can not change the password.
+func (self *Generic) ChangePassword(containerId string, password string) error {
+	opts := &GenericOpts{}
+	_, err := self.LoadOptions(containerId, opts)
+	if err != nil {
+		return err
+	}
+
+	opts.Password = password
+	err = self.rewriteCredentials(opts)
+	if err != nil {
+		return err
+	}
+
+	return self.Restart(&plugins.AppConfig{ContainerId: containerId})
+}
+
+func (self *Generic) rewriteCredentials(opts *GenericOpts) error {
+	if self.Manifest.PasswordHash != "" {
+		err := opts.hashPassword(self.Manifest.PasswordHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	written := 0
+	for _, file := range self.Manifest.Templates {
+		if len(file.Credentials) == 0 {
+			continue
+		}
+
+		destination, err := render(file.Destination, opts)
+		if err != nil {
+			return err
+		}
+		keys := []string{}
+		for _, key := range file.Credentials {
+			rendered, err := render(key, opts)
+			if err != nil {
+				return err
+			}
+			keys = append(keys, rendered)
+		}
+
+		err = self.RewriteTemplateFileLines(path.Join(self.folder, file.Source), destination, opts, keys...)
+		if err != nil {
+			return err
+		}
+		written++
+	}
+	if written == 0 {
+		return fmt.Errorf("The manifest of %s lists no credentials, change the password in the app itself", self.Name)
+	}
+	return nil
+}
+
+// port returns the host port held by an option, a free one is picked when
+// the option is empty.
+func (self *GenericOpts) port(option string) (string, error) {
+	if option == "web_port" {
+		return self.WebPort, nil
+	}
+
+	if self.Options[option] == "" {
+		p, err := self.FreePort()
+		if err != nil {
+			return "", err
+		}
+		self.setOption(option, p)
+	}
+	return self.Options[option], nil
+}
+
+// render executes a template from the manifest against the options.
+func render(text string, opts *GenericOpts) (string, error) {
+	tmpl, err := template.New("manifest").Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, opts)
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
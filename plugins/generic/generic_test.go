@@ -0,0 +1,122 @@
+package generic
+
+import (
+	"encoding/json"
+	"github.com/bytesizedhosting/bcd/plugins"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+)
+
+const testManifest = `
+name: Lidarr
+version: 2
+image: bytesized/lidarr
+ports:
+- container: 8686/tcp
+  option: web_port
+- container: 9000/tcp
+  option: api_port
+password_hash: sha1-salt
+`
+
+func TestRegisterManifests(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bcdtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ioutil.WriteFile(path.Join(dir, "genericlidarr.yml"), []byte(testManifest), 0644)
+	ioutil.WriteFile(path.Join(dir, "deluge.yml"), []byte("name: Deluge\n"), 0644)
+
+	err = RegisterManifests(dir)
+	if err != nil {
+		t.Fatal("Could not register manifests:", err)
+	}
+
+	p, err := plugins.Create("genericlidarr", nil)
+	if err != nil {
+		t.Fatal("Generic plugin was not registered:", err)
+	}
+	if p.GetVersion() != 2 || p.GetManifest().RpcName != "GenericlidarrRPC" {
+		t.Errorf("Unexpected plugin: version %d, rpc name %s", p.GetVersion(), p.GetManifest().RpcName)
+	}
+
+	for _, name := range plugins.Registered() {
+		if name == "deluge" {
+			t.Error("Manifest without an image was registered")
+		}
+	}
+}
+
+func TestOptions(t *testing.T) {
+	opts := GenericOpts{}
+	err := json.Unmarshal([]byte(`{"web_port": "8686", "api_port": "9000", "password": "secret"}`), &opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts.WebPort != "8686" || opts.Option("api_port") != "9000" || opts.Option("password") != "" {
+		t.Errorf("Options were not split correctly: %+v", opts)
+	}
+
+	err = opts.hashPassword("sha1-salt")
+	if err != nil || opts.Salt == "" || opts.EncPassword == "" {
+		t.Error("Password was not hashed:", err)
+	}
+
+	data, _ := json.Marshal(opts)
+	if !strings.Contains(string(data), `"api_port":"9000"`) || !strings.Contains(string(data), `"web_port":"8686"`) {
+		t.Error("Options were not flattened:", string(data))
+	}
+
+	rendered, err := render(`{{.WebPort}}:{{.Option "api_port"}}`, &opts)
+	if err != nil || rendered != "8686:9000" {
+		t.Errorf("Template rendered '%s': %v", rendered, err)
+	}
+}
+
+func TestRewriteCredentials(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bcdtest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ioutil.WriteFile(path.Join(dir, "app.conf.tmpl"), []byte("[web]\nport = {{.WebPort}}\npassword = {{.EncPassword}}\n"), 0644)
+	ioutil.WriteFile(path.Join(dir, "other.conf.tmpl"), []byte("password = {{.EncPassword}}\n"), 0644)
+	ioutil.WriteFile(path.Join(dir, "app.conf"), []byte("[web]\nport = 9999\n  password = old\n"), 0644)
+	ioutil.WriteFile(path.Join(dir, "other.conf"), []byte("password = old\n"), 0644)
+
+	manifest := &plugins.Manifest{Name: "app", PasswordHash: "sha256", Templates: []plugins.TemplateFile{
+		{Source: "app.conf.tmpl", Destination: "{{.ConfigFolder}}/app.conf", Credentials: []string{"[web]password ="}},
+		{Source: "other.conf.tmpl", Destination: "{{.ConfigFolder}}/other.conf"},
+	}}
+	g := New(nil, "app", manifest, dir)
+
+	opts := &GenericOpts{}
+	opts.ConfigFolder = dir
+	opts.WebPort = "8080"
+	opts.Password = "secret"
+	err = g.rewriteCredentials(opts)
+	if err != nil {
+		t.Fatal("Could not rewrite the credentials:", err)
+	}
+
+	data, _ := ioutil.ReadFile(path.Join(dir, "app.conf"))
+	expected := "[web]\nport = 9999\n  password = 2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b\n"
+	if string(data) != expected {
+		t.Errorf("Only the password line should change, got:\n%s", data)
+	}
+	data, _ = ioutil.ReadFile(path.Join(dir, "other.conf"))
+	if string(data) != "password = old\n" {
+		t.Errorf("A template without credentials was rewritten:\n%s", data)
+	}
+
+	manifest.Templates = manifest.Templates[1:]
+	if g.rewriteCredentials(opts) == nil {
+		t.Error("A manifest without credentials should not change the password")
+	}
+}
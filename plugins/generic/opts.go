@@ -0,0 +1,123 @@
+package generic
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"github.com/bytesizedhosting/bcd/core"
+	"github.com/bytesizedhosting/bcd/plugins"
+	"github.com/foomo/htpasswd"
+	"golang.org/x/crypto/bcrypt"
+	"strings"
+)
+
+// GenericOpts holds the base options plus any other option the manifest
+// declares, those end up in Options. Templates read them with
+// {{.Option "name"}}.
+type GenericOpts struct {
+	plugins.BaseOpts
+	EncPassword string            `json:"encrypted_password,omitempty"`
+	Salt        string            `json:"salt,omitempty"`
+	Options     map[string]string `json:"-"`
+}
+
+// Option returns the value of an option that is not one of the base options.
+func (self *GenericOpts) Option(name string) string {
+	return self.Options[name]
+}
+
+func (self *GenericOpts) setOption(name string, value string) {
+	if self.Options == nil {
+		self.Options = map[string]string{}
+	}
+	self.Options[name] = value
+}
+
+// The options are sent as one flat object, fields that are not part of the
+// struct are collected in Options.
+type plainOpts GenericOpts
+
+func (self *GenericOpts) UnmarshalJSON(data []byte) error {
+	err := json.Unmarshal(data, (*plainOpts)(self))
+	if err != nil {
+		return err
+	}
+
+	all := map[string]interface{}{}
+	err = json.Unmarshal(data, &all)
+	if err != nil {
+		return err
+	}
+
+	known := plugins.OptionNames(self)
+	for key, value := range all {
+		if s, ok := value.(string); ok && !known[key] {
+			self.setOption(key, s)
+		}
+	}
+	return nil
+}
+
+func (self GenericOpts) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(plainOpts(self))
+	if err != nil || len(self.Options) == 0 {
+		return data, err
+	}
+
+	all := map[string]interface{}{}
+	err = json.Unmarshal(data, &all)
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range self.Options {
+		if _, ok := all[key]; !ok {
+			all[key] = value
+		}
+	}
+	return json.Marshal(all)
+}
+
+// Password hashes a manifest can ask for, the result is available to
+// templates as {{.EncPassword}}.
+var hashers = map[string]func(opts *GenericOpts) (string, error){
+	"sha1-salt": func(opts *GenericOpts) (string, error) {
+		opts.Salt = fmt.Sprintf("%x", core.GetRandom(20))
+		sha := sha1.New()
+		sha.Write([]byte(opts.Salt))
+		sha.Write([]byte(opts.Password))
+		return fmt.Sprintf("%x", sha.Sum(nil)), nil
+	},
+	"sha256": func(opts *GenericOpts) (string, error) {
+		sha := sha256.New()
+		sha.Write([]byte(opts.Password))
+		return fmt.Sprintf("%x", sha.Sum(nil)), nil
+	},
+	"bcrypt": func(opts *GenericOpts) (string, error) {
+		hash, err := bcrypt.GenerateFromPassword([]byte(opts.Password), bcrypt.DefaultCost)
+		return string(hash), err
+	},
+	// A complete htpasswd line for the username.
+	"htpasswd": func(opts *GenericOpts) (string, error) {
+		passwords := htpasswd.HashedPasswords{}
+		err := passwords.SetPassword(opts.Username, opts.Password, htpasswd.HashBCrypt)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(passwords.Bytes())), nil
+	},
+}
+
+func (self *GenericOpts) hashPassword(method string) error {
+	hasher, ok := hashers[method]
+	if !ok {
+		return fmt.Errorf("Unknown password hash '%s'", method)
+	}
+
+	hash, err := hasher(self)
+	if err != nil {
+		return err
+	}
+	self.EncPassword = hash
+	return nil
+}
@@ -0,0 +1,43 @@
+package generic
+
+import (
+	log "github.com/Sirupsen/logrus"
+	"github.com/bytesizedhosting/bcd/jobs"
+	"github.com/bytesizedhosting/bcd/plugins"
+)
+
+// GenericRPC is registered under the rpc_name of the manifest, so every
+// generic app gets its own service.
+type GenericRPC struct {
+	base *Generic
+	plugins.BaseRPC
+}
+
+func (self *GenericRPC) Reinstall(opts *GenericOpts, job *jobs.Job) error {
+	err := self.base.Uninstall(&plugins.AppConfig{ContainerId: opts.ContainerId})
+	if err != nil {
+		log.Infoln("Could not remove Docker container but since this is a reinstall we don't care.")
+	}
+	self.Install(opts, job)
+	return nil
+}
+
+func (self *GenericRPC) Install(opts *GenericOpts, job *jobs.Job) error {
+	running := jobs.New(self.base.GetName(), *opts, opts.CallbackUrl)
+	opts.SetJob(running)
+	*job = running.Snapshot()
+
+	log.Debugln(self.base.GetName(), "options:", opts)
+	jobs.Queue.Run(running, func() {
+		err := self.base.Install(opts)
+		if err != nil {
+			log.Debugln(self.base.GetName(), "installation received an error:", err)
+			running.Fail(err)
+		} else {
+			log.Infoln(self.base.GetName(), "installation completed")
+			running.Succeed(*opts)
+		}
+	})
+
+	return nil
+}
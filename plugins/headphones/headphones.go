@@ -12,6 +12,16 @@ type Headphones struct {
 	imageName string
 }
 
+func init() {
+	plugins.Register("headphones", func(client *docker.Client) (plugins.Plugin, error) {
+		p, err := New(client)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
 func New(client *docker.Client) (*Headphones, error) {
 	manifest, err := plugins.LoadManifest("headphones")
 
@@ -51,7 +61,7 @@ func (self *Headphones) Install(opts *HeadphonesOpts) error {
 	}
 
 	log.Debugln("Pulling docker image", self.imageName)
-	err = self.DockerClient.PullImage(docker.PullImageOptions{Repository: self.imageName}, docker.AuthConfiguration{})
+	err = self.PullImage(self.imageName, opts)
 	if err != nil {
 		return err
 	}
@@ -68,7 +78,7 @@ func (self *Headphones) Install(opts *HeadphonesOpts) error {
 	conf := docker.Config{Env: []string{"PUID=" + opts.User.Uid}, Image: self.imageName}
 
 	log.Debugln("Creating docker container")
-	c, err := self.DockerClient.CreateContainer(docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_headphones_" + opts.WebPort})
+	c, err := self.CreateContainer(opts, docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_headphones_" + opts.WebPort})
 
 	if err != nil {
 		return err
@@ -76,7 +86,7 @@ func (self *Headphones) Install(opts *HeadphonesOpts) error {
 
 	log.Debugln("Starting docker container")
 
-	err = self.DockerClient.StartContainer(c.ID, nil)
+	err = self.StartContainer(c.ID, opts)
 	if err != nil {
 		return err
 	}
@@ -85,3 +95,22 @@ func (self *Headphones) Install(opts *HeadphonesOpts) error {
 
 	return nil
 }
+
+// ChangePassword only touches http_password, Headphones keeps its other settings
+// in the same file.
+func (self *Headphones) ChangePassword(containerId string, password string) error {
+	opts := &HeadphonesOpts{}
+	_, err := self.LoadOptions(containerId, opts)
+	if err != nil {
+		return err
+	}
+
+	opts.Password = password
+
+	err = self.RewriteTemplateLines("plugins/headphones/data/config.ini", opts.ConfigFolder+"/config.ini", opts, "http_password =")
+	if err != nil {
+		return err
+	}
+
+	return self.Restart(&plugins.AppConfig{ContainerId: containerId})
+}
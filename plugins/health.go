@@ -0,0 +1,329 @@
+package plugins
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	log "github.com/Sirupsen/logrus"
+	"github.com/bytesizedhosting/bcd/events"
+	"github.com/fsouza/go-dockerclient"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Kinds of health probes a manifest can declare.
+const (
+	ProbeHTTP = "http"
+	ProbeTCP  = "tcp"
+)
+
+// Health verdicts.
+const (
+	HealthHealthy   = "healthy"
+	HealthUnhealthy = "unhealthy"
+	HealthStopped   = "stopped"
+	// The app declares no probes.
+	HealthUnknown = "unknown"
+)
+
+const (
+	// How often the probes run when the config does not say.
+	DefaultHealthInterval = 30 * time.Second
+
+	probeTimeout = 5 * time.Second
+
+	// An app has to stay up at least this long to count as healthy, apps that
+	// crash on start often do so after a few seconds.
+	minUptime = 5 * time.Second
+)
+
+var probeTypes = map[string]bool{ProbeHTTP: true, ProbeTCP: true}
+
+// HealthProbe checks a port of an app on the host, the port is taken from
+// the install option named by Option.
+type HealthProbe struct {
+	Type   string `json:"type"`
+	Option string `json:"option"`
+	// Only for http probes, the path defaults to / and any status below 500
+	// passes unless Status is set.
+	Path   string `json:"path,omitempty"`
+	Status int    `json:"status,omitempty"`
+}
+
+// Health is the combined verdict of the probes of an app.
+type Health struct {
+	Status      string     `json:"status"`
+	CheckedAt   *time.Time `json:"checked_at,omitempty"`
+	LastSuccess *time.Time `json:"last_success,omitempty"`
+	LastFailure *time.Time `json:"last_failure,omitempty"`
+	LastError   string     `json:"last_error,omitempty"`
+}
+
+// run checks the probe against the options the app was installed with.
+func (self HealthProbe) run(options map[string]interface{}) error {
+	port, _ := options[self.Option].(string)
+	if port == "" {
+		return fmt.Errorf("%s probe: option %s holds no port", self.Type, self.Option)
+	}
+	address := net.JoinHostPort("127.0.0.1", port)
+
+	switch self.Type {
+	case ProbeTCP:
+		conn, err := net.DialTimeout("tcp", address, probeTimeout)
+		if err != nil {
+			return fmt.Errorf("tcp probe on %s: %s", self.Option, err)
+		}
+		conn.Close()
+		return nil
+	case ProbeHTTP:
+		path := self.Path
+		if path == "" {
+			path = "/"
+		}
+		res, err := probeClient.Get("http://" + address + path)
+		if err != nil {
+			return fmt.Errorf("http probe on %s: %s", self.Option, err)
+		}
+		res.Body.Close()
+
+		if (self.Status != 0 && res.StatusCode != self.Status) || (self.Status == 0 && res.StatusCode >= 500) {
+			return fmt.Errorf("http probe on %s: %s answered %s", self.Option, path, res.Status)
+		}
+		return nil
+	}
+	return fmt.Errorf("Unknown probe type '%s'", self.Type)
+}
+
+// Redirects usually lead to a login page, answering with one is healthy
+// enough.
+var probeClient = &http.Client{
+	Timeout: probeTimeout,
+	CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// probe runs the probes of the manifest, or when there are none checks that
+// the web port accepts connections.
+func (self *Base) probe(container *docker.Container) error {
+	options := probeOptions(container)
+	if self.Manifest != nil && len(self.Manifest.Health) > 0 {
+		for _, probe := range self.Manifest.Health {
+			if err := probe.run(options); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	port := webPort(container)
+	if port == "" {
+		return nil
+	}
+	return HealthProbe{Type: ProbeTCP, Option: "web_port"}.run(map[string]interface{}{"web_port": port})
+}
+
+// WaitHealthy waits until the container has been running for a few seconds
+// and passes its probes.
+func (self *Base) WaitHealthy(ctx context.Context, id string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		container, err := self.DockerClient.InspectContainer(id)
+		if err != nil {
+			return err
+		}
+
+		state := container.State
+		if !state.Running && !state.Restarting {
+			return fmt.Errorf("The container stopped with exit code %d", state.ExitCode)
+		}
+		if state.Running && time.Since(state.StartedAt) >= minUptime {
+			err = self.probe(container)
+			if err == nil {
+				return nil
+			}
+		}
+
+		if time.Now().After(deadline) {
+			if err != nil {
+				return fmt.Errorf("The app did not become healthy within %s: %s", timeout, err)
+			}
+			return fmt.Errorf("The app did not become healthy within %s", timeout)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// HealthMonitor keeps the outcome of the probes of every app.
+type HealthMonitor struct {
+	lock    sync.Mutex
+	results map[string]*Health
+}
+
+// Probes holds the health of the apps, Status reads from it.
+var Probes = NewHealthMonitor()
+
+func NewHealthMonitor() *HealthMonitor {
+	return &HealthMonitor{results: map[string]*Health{}}
+}
+
+// Run probes every running app each interval, lookup finds the plugin of an
+// app for its manifest. It never returns.
+func (self *HealthMonitor) Run(client *docker.Client, lookup func(name string) Plugin, interval time.Duration) {
+	for range time.Tick(interval) {
+		apps, err := FindApps(client)
+		if err != nil {
+			log.Debugln("Could not list apps to probe:", err)
+			continue
+		}
+
+		seen := map[string]bool{}
+		for _, app := range apps {
+			seen[app.ContainerId] = true
+			p := lookup(app.Plugin)
+			if p == nil || app.State != "running" {
+				continue
+			}
+			container, err := client.InspectContainer(app.ContainerId)
+			if err != nil {
+				continue
+			}
+			self.Check(p, container)
+		}
+		self.forget(seen)
+	}
+}
+
+// Status returns the last verdict on a container, the health is unknown until
+// Run probed it.
+func (self *HealthMonitor) Status(p Plugin, container *docker.Container) *Health {
+	if !container.State.Running {
+		return &Health{Status: HealthStopped}
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	last := self.results[container.ID]
+	if last == nil {
+		return &Health{Status: HealthUnknown}
+	}
+	res := *last
+	return &res
+}
+
+// Check probes a running container now and records the outcome. A change
+// of the verdict is published on the containers topic. Apps without a port
+// for every probe can not be probed, their health is unknown.
+func (self *HealthMonitor) Check(p Plugin, container *docker.Container) *Health {
+	manifest := p.GetManifest()
+	if manifest == nil || len(manifest.Health) == 0 {
+		return &Health{Status: HealthUnknown}
+	}
+
+	options := probeOptions(container)
+	for _, probe := range manifest.Health {
+		if port, _ := options[probe.Option].(string); port == "" {
+			return &Health{Status: HealthUnknown}
+		}
+	}
+
+	var err error
+	for _, probe := range manifest.Health {
+		if err = probe.run(options); err != nil {
+			break
+		}
+	}
+
+	now := time.Now()
+
+	self.lock.Lock()
+	health := self.results[container.ID]
+	if health == nil {
+		health = &Health{Status: HealthUnknown}
+		self.results[container.ID] = health
+	}
+	previous := health.Status
+	health.CheckedAt = &now
+	if err != nil {
+		health.Status = HealthUnhealthy
+		health.LastFailure = &now
+		health.LastError = err.Error()
+	} else {
+		health.Status = HealthHealthy
+		health.LastSuccess = &now
+	}
+	res := *health
+	self.lock.Unlock()
+
+	if previous != res.Status {
+		if err != nil {
+			log.Infof("App %s is unhealthy: %s", strings.TrimPrefix(container.Name, "/"), err)
+		}
+		events.Publish(events.TopicContainers, "container.health", struct {
+			ContainerId string `json:"container_id"`
+			Plugin      string `json:"plugin"`
+			*Health
+		}{container.ID, p.GetName(), &res})
+	}
+	return &res
+}
+
+// forget drops the results of containers that are gone.
+func (self *HealthMonitor) forget(seen map[string]bool) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	for id := range self.results {
+		if !seen[id] {
+			delete(self.results, id)
+		}
+	}
+}
+
+// probeOptions returns the options the probes take their ports from, for
+// containers without the options label only the web port is known.
+func probeOptions(container *docker.Container) map[string]interface{} {
+	options := labelOptions(container)
+	if port, _ := options["web_port"].(string); port == "" {
+		if port = webPort(container); port != "" {
+			options["web_port"] = port
+		}
+	}
+	return options
+}
+
+// labelOptions returns the options recorded on the container.
+func labelOptions(container *docker.Container) map[string]interface{} {
+	options := map[string]interface{}{}
+	if container.Config != nil {
+		json.Unmarshal([]byte(container.Config.Labels[LabelOptions]), &options)
+	}
+	return options
+}
+
+// webPort returns the host port the app listens on, taken from the options
+// it was installed with or else from its published ports.
+func webPort(container *docker.Container) string {
+	if port, ok := labelOptions(container)["web_port"].(string); ok && port != "" {
+		return port
+	}
+
+	if container.HostConfig != nil {
+		for _, bindings := range container.HostConfig.PortBindings {
+			for _, binding := range bindings {
+				if binding.HostPort != "" {
+					return binding.HostPort
+				}
+			}
+		}
+	}
+	return ""
+}
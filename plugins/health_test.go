@@ -0,0 +1,60 @@
+package plugins
+
+import (
+	"github.com/fsouza/go-dockerclient"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHealthProbes(t *testing.T) {
+	status := 200
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+	port := server.URL[strings.LastIndex(server.URL, ":")+1:]
+
+	p := &Base{Name: "app", Manifest: &Manifest{Health: []HealthProbe{{Type: ProbeHTTP, Option: "web_port"}, {Type: ProbeTCP, Option: "web_port"}}}}
+	container := &docker.Container{ID: "app", State: docker.State{Running: true},
+		Config: &docker.Config{Labels: map[string]string{LabelOptions: `{"web_port":"` + port + `"}`}}}
+
+	monitor := NewHealthMonitor()
+	if monitor.Status(p, container).Status != HealthUnknown {
+		t.Error("Expected the health to be unknown before the first check")
+	}
+	health := monitor.Check(p, container)
+	if health.Status != HealthHealthy || health.LastSuccess == nil {
+		t.Fatalf("Expected the app to be healthy, got: %+v", health)
+	}
+
+	status = 503
+	health = monitor.Check(p, container)
+	if health.Status != HealthUnhealthy || !strings.Contains(health.LastError, "503") || health.LastSuccess == nil {
+		t.Errorf("Expected the failure next to the last success, got: %+v", health)
+	}
+	if monitor.Status(p, container).Status != HealthUnhealthy {
+		t.Error("Expected the last verdict to be returned")
+	}
+
+	// Installed before bcd labeled its containers, only the published port
+	// is known.
+	unlabeled := &docker.Container{ID: "old", State: docker.State{Running: true}, Config: &docker.Config{},
+		HostConfig: &docker.HostConfig{PortBindings: map[docker.Port][]docker.PortBinding{"80/tcp": {{HostPort: port}}}}}
+	if health := monitor.Check(p, unlabeled); health.Status != HealthUnhealthy || !strings.Contains(health.LastError, "503") {
+		t.Errorf("Expected the published port to be probed, got: %+v", health)
+	}
+	unlabeled.HostConfig = nil
+	if health := monitor.Check(p, unlabeled); health.Status != HealthUnknown {
+		t.Errorf("Expected an app without ports to be unknown, got: %+v", health)
+	}
+	if monitor.Status(p, unlabeled).Status != HealthUnhealthy {
+		t.Error("Expected an unknown verdict not to be recorded")
+	}
+
+	container.State.Running = false
+	if monitor.Status(p, container).Status != HealthStopped {
+		t.Error("Expected a stopped container to be reported as such")
+	}
+}
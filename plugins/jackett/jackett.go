@@ -1,12 +1,15 @@
 package jackett
 
 import (
+	"bytes"
 	"crypto/sha512"
+	"encoding/json"
 	"fmt"
 	log "github.com/Sirupsen/logrus"
 	"github.com/bytesizedhosting/bcd/core"
 	"github.com/bytesizedhosting/bcd/plugins"
 	"github.com/fsouza/go-dockerclient"
+	"io/ioutil"
 	"net/rpc"
 	"path"
 )
@@ -16,6 +19,16 @@ type Jackett struct {
 	imageName string
 }
 
+func init() {
+	plugins.Register("jackett", func(client *docker.Client) (plugins.Plugin, error) {
+		p, err := New(client)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
 func New(client *docker.Client) (*Jackett, error) {
 	manifest, err := plugins.LoadManifest("jackett")
 
@@ -38,8 +51,6 @@ type JackettOpts struct {
 }
 
 func (opts *JackettOpts) hashPassword() string {
-	opts.ApiKey = fmt.Sprintf("%x", core.GetRandom(16))
-	log.Debugln("Apikey:", opts.ApiKey)
 	passString := opts.Password + opts.ApiKey
 
 	// Jackett uses UTF-16 little endian for the sha encryption, so let's fake that.
@@ -61,6 +72,8 @@ func (self *Jackett) Install(opts *JackettOpts) error {
 	if err != nil {
 		return err
 	}
+	opts.ApiKey = fmt.Sprintf("%x", core.GetRandom(16))
+	log.Debugln("Apikey:", opts.ApiKey)
 	opts.hashPassword()
 
 	log.WithFields(log.Fields{
@@ -82,7 +95,7 @@ func (self *Jackett) Install(opts *JackettOpts) error {
 	}
 
 	log.Debugln("Pulling docker image", self.imageName)
-	err = self.DockerClient.PullImage(docker.PullImageOptions{Repository: self.imageName}, docker.AuthConfiguration{})
+	err = self.PullImage(self.imageName, opts)
 	if err != nil {
 		return err
 	}
@@ -99,7 +112,7 @@ func (self *Jackett) Install(opts *JackettOpts) error {
 	conf := docker.Config{Env: []string{"PUID=" + opts.User.Uid}, Image: self.imageName}
 
 	log.Debugln("Creating docker container")
-	c, err := self.DockerClient.CreateContainer(docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_jackett_" + opts.WebPort})
+	c, err := self.CreateContainer(opts, docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_jackett_" + opts.WebPort})
 
 	if err != nil {
 		return err
@@ -107,7 +120,7 @@ func (self *Jackett) Install(opts *JackettOpts) error {
 
 	log.Debugln("Starting docker container")
 
-	err = self.DockerClient.StartContainer(c.ID, nil)
+	err = self.StartContainer(c.ID, opts)
 	if err != nil {
 		return err
 	}
@@ -116,3 +129,40 @@ func (self *Jackett) Install(opts *JackettOpts) error {
 
 	return nil
 }
+
+// ChangePassword sets the admin password. Jackett hashes it together with
+// the API key, that is read back from the config so it stays the same.
+func (self *Jackett) ChangePassword(containerId string, password string) error {
+	opts := &JackettOpts{}
+	_, err := self.LoadOptions(containerId, opts)
+	if err != nil {
+		return err
+	}
+
+	configFile := path.Join(opts.ConfigFolder, "Jackett", "ServerConfig.json")
+	data, err := ioutil.ReadFile(configFile)
+	if err != nil {
+		return err
+	}
+	config := struct {
+		APIKey string
+	}{}
+	err = json.Unmarshal(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf")), &config)
+	if err != nil {
+		return fmt.Errorf("Could not read the API key from %s: %s", configFile, err)
+	}
+	if config.APIKey == "" {
+		return fmt.Errorf("%s holds no API key", configFile)
+	}
+
+	opts.Password = password
+	opts.ApiKey = config.APIKey
+	opts.hashPassword()
+
+	err = self.RewriteTemplateLines("plugins/jackett/data/ServerConfig.json", configFile, opts, `"AdminPassword":`)
+	if err != nil {
+		return err
+	}
+
+	return self.Restart(&plugins.AppConfig{ContainerId: containerId})
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/bytesizedhosting/bcd/jobs"
 	"github.com/bytesizedhosting/bcd/plugins"
+	"github.com/fsouza/go-dockerclient"
 	"net/rpc"
 )
 
@@ -11,6 +12,27 @@ type JobRPC struct {
 	plugins.Base
 }
 
+type TailArgs struct {
+	JobId  string `json:"job_id"`
+	Cursor int    `json:"cursor"`
+}
+
+type TailResponse struct {
+	Status int            `json:"status"`
+	Lines  []jobs.LogLine `json:"lines"`
+	Cursor int            `json:"cursor"`
+}
+
+type ListResponse struct {
+	Jobs []jobs.Job `json:"jobs"`
+}
+
+func init() {
+	plugins.Register("jobs", func(*docker.Client) (plugins.Plugin, error) {
+		return New(), nil
+	})
+}
+
 func New() *JobRPC {
 	return &JobRPC{plugins.Base{Name: "jobs", Version: 1}}
 }
@@ -21,10 +43,41 @@ func (self *JobRPC) RegisterRPC(server *rpc.Server) {
 func (self *JobRPC) Get(jobId string, job *jobs.Job) error {
 	njob := jobs.Storage.Get(jobId)
 
-	if (*njob == jobs.Job{}) {
-		return fmt.Errorf("Job status got lost, most likely during a reboot of the daemon")
+	if njob.Id == "" {
+		return fmt.Errorf("Could not find job '%s', it might have been pruned", jobId)
 	} else {
 		*job = *njob
 	}
 	return nil
 }
+
+// List returns all known jobs matching the filter, newest first.
+func (self *JobRPC) List(filter *jobs.ListFilter, res *ListResponse) error {
+	res.Jobs = jobs.Storage.List(*filter)
+	return nil
+}
+
+// Tail returns the log lines of a job after the given cursor, pass the
+// returned cursor in the next call to only receive new lines.
+func (self *JobRPC) Tail(args *TailArgs, res *TailResponse) error {
+	job := jobs.Storage.Get(args.JobId)
+	if job.Id == "" {
+		return fmt.Errorf("Could not find job '%s', it might have been pruned", args.JobId)
+	}
+
+	res.Status = job.Status
+	res.Lines = job.Tail(args.Cursor)
+	res.Cursor = job.LogCursor
+	return nil
+}
+
+// Cancel stops a queued or running job and rolls back what it already did.
+func (self *JobRPC) Cancel(jobId string, success *bool) error {
+	err := jobs.Cancel(jobId)
+	if err != nil {
+		return err
+	}
+
+	*success = true
+	return nil
+}
@@ -0,0 +1,341 @@
+package plugins
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ghodss/yaml"
+	"net/rpc"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	keyPattern         = regexp.MustCompile(`^("[^"]*"|'[^']*'|[^\s"'#:][^:]*?):(\s|$)`)
+	placeholderPattern = regexp.MustCompile(`##(\w+)##`)
+
+	manifestKeys = OptionNames(Manifest{})
+	optionKeys   = OptionNames(MethodOption{})
+	optionTypes  = map[string]bool{TypeString: true, TypeInt: true, TypePort: true, TypePath: true, TypeBool: true, "boolean": true, TypeEnum: true, TypeSecret: true}
+
+	errProbe = errors.New("probe")
+)
+
+// LintManifest checks a complete manifest against the manifest schema and,
+// when p is not nil, against the RPC methods and options of the plugin. It
+// returns a description of every problem found.
+func LintManifest(data []byte, p Plugin) []string {
+	problems := lintSyntax(data, false)
+
+	manifest := Manifest{}
+	err := yaml.Unmarshal(data, &manifest)
+	if err != nil {
+		return append(problems, fmt.Sprintf("Could not parse the manifest: %s", err))
+	}
+
+	return append(problems, lintManifest(&manifest, p)...)
+}
+
+// LintOverlay checks a custom manifest that is merged onto a built-in one,
+// the merged result has to be a valid manifest.
+func LintOverlay(builtin []byte, overlay []byte, p Plugin) []string {
+	problems := lintSyntax(overlay, true)
+
+	manifest, err := OverlayManifest(builtin, overlay)
+	if err != nil {
+		return append(problems, fmt.Sprintf("Could not merge the manifest: %s", err))
+	}
+
+	return append(problems, lintManifest(manifest, p)...)
+}
+
+// lintSyntax reports duplicate and unknown keys, both of which the parser
+// silently ignores.
+func lintSyntax(data []byte, overlay bool) []string {
+	problems := duplicateKeys(data)
+
+	raw, err := yamlMap(data)
+	if err != nil {
+		return append(problems, fmt.Sprintf("Invalid YAML: %s", err))
+	}
+
+	for _, key := range sortedKeys(raw) {
+		if !manifestKeys[key] {
+			problems = append(problems, fmt.Sprintf("Unknown key '%s'", key))
+		}
+	}
+
+	methods, _ := raw["method_options"].(map[string]interface{})
+	for _, method := range sortedKeys(methods) {
+		options, _ := methods[method].([]interface{})
+		for i, o := range options {
+			option, _ := o.(map[string]interface{})
+			for _, key := range sortedKeys(option) {
+				if !optionKeys[key] && !(overlay && key == "remove") {
+					problems = append(problems, fmt.Sprintf("Unknown key '%s' in option %d of method %s", key, i+1, method))
+				}
+			}
+		}
+	}
+
+	return problems
+}
+
+func lintManifest(manifest *Manifest, p Plugin) []string {
+	problems := []string{}
+	add := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	if manifest.Name == "" {
+		add("The name is missing")
+	}
+	// The generic plugin picks an rpc_name itself.
+	if manifest.RpcName == "" && manifest.Image == "" {
+		add("The rpc_name is missing")
+	}
+	if manifest.Version <= 0 {
+		add("The version is missing")
+	}
+	if manifest.PasswordHash != "" && manifest.Image == "" {
+		add("A password_hash is only used together with an image")
+	}
+
+	if manifest.Resources != nil {
+		for _, problem := range manifest.Resources.Validate() {
+			add("The default resources are invalid: %s", problem)
+		}
+	}
+
+	for i, probe := range manifest.Health {
+		if !probeTypes[probe.Type] {
+			add("Health probe %d has unknown type '%s'", i+1, probe.Type)
+		}
+		if probe.Option == "" {
+			add("Health probe %d names no option to take the port from", i+1)
+		}
+	}
+
+	for i, file := range manifest.Templates {
+		for _, key := range file.Credentials {
+			// An empty key matches the first line of the file.
+			if strings.TrimSpace(key) == "" {
+				add("Template %d lists an empty credential line", i+1)
+			}
+		}
+	}
+
+	for _, method := range sortedKeys(manifest.MethodOptions) {
+		names := map[string]bool{}
+		for _, option := range manifest.MethodOptions[method] {
+			switch {
+			case option.Name == "":
+				add("An option of method %s has no name", method)
+				continue
+			case names[option.Name]:
+				add("Option %s of method %s is listed twice", option.Name, method)
+			}
+			names[option.Name] = true
+
+			if option.Type != "" && !optionTypes[option.Type] {
+				add("Option %s of method %s has unknown type '%s'", option.Name, method, option.Type)
+				continue
+			}
+			if option.Type == TypeEnum && len(option.Values) == 0 {
+				add("Enum option %s of method %s lists no values", option.Name, method)
+			}
+			if option.Pattern != "" {
+				if _, err := regexp.Compile(option.Pattern); err != nil {
+					add("Option %s of method %s has an invalid pattern: %s", option.Name, method, err)
+				}
+			}
+			// The default is shown to users, it has to be a value they could
+			// send themselves.
+			defaults := option
+			defaults.Required = false
+			if message := defaults.check(option.DefaultValue, true); message != "" {
+				add("The default of option %s of method %s %s", option.Name, method, message)
+			}
+		}
+	}
+
+	if p == nil {
+		return problems
+	}
+
+	server := rpc.NewServer()
+	p.RegisterRPC(server)
+
+	rpcName := manifest.RpcName
+	if rpcName == "" && p.GetManifest() != nil {
+		rpcName = p.GetManifest().RpcName
+	}
+
+	for _, method := range manifest.ExposedMethods {
+		if _, ok := rpcArgs(server, rpcName+"."+method); !ok {
+			add("Exposed method %s does not exist on %s", method, rpcName)
+		}
+	}
+	exposed := map[string]bool{}
+	for _, method := range manifest.ExposedMethods {
+		exposed[method] = true
+	}
+	for _, method := range manifest.UnsupportedMethods {
+		if exposed[method] {
+			add("Method %s is both exposed and unsupported", method)
+		}
+		if _, ok := rpcArgs(server, rpcName+"."+method); !ok {
+			add("Unsupported method %s does not exist on %s", method, rpcName)
+		}
+	}
+	for _, method := range sortedKeys(manifest.MethodOptions) {
+		if _, ok := rpcArgs(server, rpcName+"."+method); !ok {
+			add("Options are listed for method %s which does not exist on %s", method, rpcName)
+		}
+	}
+
+	args, ok := rpcArgs(server, rpcName+".Install")
+	if !ok {
+		return problems
+	}
+
+	known := OptionNames(args)
+	// Options that decode themselves can accept every option the manifest
+	// declares, like those of the generic plugin.
+	if _, custom := args.(json.Unmarshaler); custom {
+		for _, option := range manifest.MethodOptions["Install"] {
+			known[option.Name] = true
+		}
+	} else {
+		// Calls are checked against the manifest, an option the plugin
+		// reads but the manifest leaves out can never be set.
+		declared := map[string]bool{}
+		for _, option := range manifest.MethodOptions["Install"] {
+			declared[option.Name] = true
+		}
+		for _, name := range sortedKeys(known) {
+			if !declared[name] && !commonOptions[name] {
+				add("Install option %s of the plugin is not declared in the manifest", name)
+			}
+		}
+	}
+
+	for i, probe := range manifest.Health {
+		if probe.Option != "" && !known[probe.Option] {
+			add("Health probe %d uses option %s which is not an install option", i+1, probe.Option)
+		}
+	}
+	for _, name := range manifest.ShowOptions {
+		if !known[name] {
+			add("Show option %s is not an install option", name)
+		}
+	}
+	for _, match := range placeholderPattern.FindAllStringSubmatch(manifest.WebUrlFormat, -1) {
+		if match[1] != "ip" && !known[match[1]] {
+			add("Placeholder ##%s## in web_url_format does not resolve", match[1])
+		}
+	}
+
+	return problems
+}
+
+// duplicateKeys finds keys that appear twice in the same block mapping, the
+// YAML parser keeps the last one without a warning. Flow style mappings are
+// not checked.
+func duplicateKeys(data []byte) []string {
+	type mapping struct {
+		indent int
+		keys   map[string]bool
+	}
+
+	problems := []string{}
+	stack := []*mapping{}
+	for n, line := range strings.Split(string(data), "\n") {
+		content := strings.TrimLeft(line, " ")
+		if content == "" || content[0] == '#' {
+			continue
+		}
+		indent := len(line) - len(content)
+
+		// A list item starts a new mapping at the indent of its content.
+		item := strings.HasPrefix(content, "- ")
+		if item {
+			content = strings.TrimLeft(content[2:], " ")
+			indent = len(line) - len(content)
+		}
+
+		for len(stack) > 0 {
+			top := stack[len(stack)-1]
+			if top.indent < indent || (top.indent == indent && !item) {
+				break
+			}
+			stack = stack[:len(stack)-1]
+		}
+
+		match := keyPattern.FindStringSubmatch(content)
+		if match == nil {
+			continue
+		}
+		if len(stack) == 0 || stack[len(stack)-1].indent < indent {
+			stack = append(stack, &mapping{indent: indent, keys: map[string]bool{}})
+		}
+
+		key := strings.Trim(match[1], `"'`)
+		top := stack[len(stack)-1]
+		if top.keys[key] {
+			problems = append(problems, fmt.Sprintf("Line %d: duplicate key '%s'", n+1, key))
+		}
+		top.keys[key] = true
+	}
+	return problems
+}
+
+// rpcArgs returns the arguments a method on the server takes, without
+// calling it.
+func rpcArgs(server *rpc.Server, method string) (interface{}, bool) {
+	codec := &probeCodec{method: method}
+	server.ServeRequest(codec)
+	return codec.args, codec.args != nil
+}
+
+// probeCodec asks for a method and refuses to decode its arguments, the
+// server only hands over the arguments when the method exists.
+type probeCodec struct {
+	method string
+	args   interface{}
+}
+
+func (self *probeCodec) ReadRequestHeader(r *rpc.Request) error {
+	r.ServiceMethod = self.method
+	return nil
+}
+
+func (self *probeCodec) ReadRequestBody(x interface{}) error {
+	self.args = x
+	return errProbe
+}
+
+func (self *probeCodec) WriteResponse(r *rpc.Response, x interface{}) error {
+	return nil
+}
+
+func (self *probeCodec) Close() error {
+	return nil
+}
+
+// sortedKeys returns the keys of a map with string keys in order.
+func sortedKeys(m interface{}) []string {
+	value := reflect.ValueOf(m)
+	if value.Kind() != reflect.Map {
+		return nil
+	}
+
+	keys := []string{}
+	for _, key := range value.MapKeys() {
+		keys = append(keys, key.String())
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,120 @@
+package plugins_test
+
+import (
+	"github.com/bytesizedhosting/bcd/plugins"
+	_ "github.com/bytesizedhosting/bcd/plugins/cardigann"
+	_ "github.com/bytesizedhosting/bcd/plugins/couchpotato"
+	_ "github.com/bytesizedhosting/bcd/plugins/deluge"
+	_ "github.com/bytesizedhosting/bcd/plugins/filebot"
+	_ "github.com/bytesizedhosting/bcd/plugins/headphones"
+	_ "github.com/bytesizedhosting/bcd/plugins/jackett"
+	_ "github.com/bytesizedhosting/bcd/plugins/murmur"
+	_ "github.com/bytesizedhosting/bcd/plugins/nzbget"
+	_ "github.com/bytesizedhosting/bcd/plugins/plex"
+	_ "github.com/bytesizedhosting/bcd/plugins/plexpy"
+	_ "github.com/bytesizedhosting/bcd/plugins/plexrequests"
+	_ "github.com/bytesizedhosting/bcd/plugins/portainer"
+	_ "github.com/bytesizedhosting/bcd/plugins/radarr"
+	_ "github.com/bytesizedhosting/bcd/plugins/resilio"
+	_ "github.com/bytesizedhosting/bcd/plugins/rocketchat"
+	_ "github.com/bytesizedhosting/bcd/plugins/rtorrent"
+	_ "github.com/bytesizedhosting/bcd/plugins/sickrage"
+	_ "github.com/bytesizedhosting/bcd/plugins/sonarr"
+	_ "github.com/bytesizedhosting/bcd/plugins/subsonic"
+	_ "github.com/bytesizedhosting/bcd/plugins/syncthing"
+	_ "github.com/bytesizedhosting/bcd/plugins/vnc"
+	_ "github.com/bytesizedhosting/bcd/plugins/znc"
+	"path"
+	"strings"
+	"testing"
+)
+
+func TestLintEmbeddedManifests(t *testing.T) {
+	linted := 0
+	for _, asset := range plugins.AssetNames() {
+		if path.Base(asset) != "manifest.yml" {
+			continue
+		}
+		name := strings.Split(asset, "/")[1]
+
+		p, err := plugins.Create(name, nil)
+		if err != nil {
+			t.Errorf("No plugin is registered for %s: %s", asset, err)
+			continue
+		}
+
+		data, _ := plugins.Asset(asset)
+		for _, problem := range plugins.LintManifest(data, p) {
+			t.Errorf("%s: %s", asset, problem)
+		}
+		// Every app has the method, clients have to know whether it works.
+		manifest := p.GetManifest()
+		if !contains(manifest.ExposedMethods, "ChangePassword") && !contains(manifest.UnsupportedMethods, "ChangePassword") {
+			t.Errorf("%s: ChangePassword is neither exposed nor unsupported", asset)
+		}
+		linted++
+	}
+
+	if linted == 0 {
+		t.Error("No embedded manifests were found")
+	}
+}
+
+func TestLintProblems(t *testing.T) {
+	manifest := `
+name: Broken
+rpc_name: DelugeRPC
+version: 1
+exposed_methods:
+- Install
+- Explode
+unsupported_methods:
+- Install
+show_options:
+- colour
+web_url_format: http://##ip##:##web_port##/##path##
+templates:
+- source: deluge.conf
+  destination: /config/deluge.conf
+  credentials:
+  - ""
+method_options:
+  Install:
+  - name: web_port
+    type: port
+    hint: one
+    hint: two
+  - name: mode
+    type: enum
+  - name: folder
+    type: path
+    default_value: relative
+    colour: red
+`
+	p, _ := plugins.Create("deluge", nil)
+	problems := plugins.LintManifest([]byte(manifest), p)
+
+	expected := []string{"duplicate key 'hint'", "Unknown key 'colour'", "lists no values", "must be an absolute path",
+		"empty credential line", "Exposed method Explode", "both exposed and unsupported", "Install option daemon_port", "Show option colour", "##path##"}
+	for _, e := range expected {
+		found := false
+		for _, problem := range problems {
+			found = found || strings.Contains(problem, e)
+		}
+		if !found {
+			t.Errorf("Expected a problem containing '%s', got: %v", e, problems)
+		}
+	}
+	if len(problems) != len(expected) {
+		t.Errorf("Expected %d problems, got: %v", len(expected), problems)
+	}
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
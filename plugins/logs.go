@@ -0,0 +1,219 @@
+package plugins
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/fsouza/go-dockerclient"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultLogLines = 100
+	MaxLogLines     = 5000
+
+	// How long a follow call waits for new lines, in seconds.
+	DefaultLogWait = 30
+	MaxLogWait     = 120
+
+	// Lines that arrive together are returned together.
+	logBatchDelay = 200 * time.Millisecond
+)
+
+// LogsOpts selects the lines to return. Without Since the last Lines lines
+// are returned, with it the lines written after it. Follow waits up to Wait
+// seconds for new lines when there are none yet, callers long-poll by
+// passing NextSince of the last result as Since.
+type LogsOpts struct {
+	ContainerId string `json:"container_id"`
+	Lines       int    `json:"lines,omitempty"`
+	Since       string `json:"since,omitempty"`
+	Follow      bool   `json:"follow,omitempty"`
+	Wait        int    `json:"wait,omitempty"`
+}
+
+type LogLine struct {
+	Time time.Time `json:"time"`
+	Text string    `json:"text"`
+}
+
+type LogsResult struct {
+	Stdout []LogLine `json:"stdout"`
+	Stderr []LogLine `json:"stderr"`
+	// Pass as since to get the lines after these.
+	NextSince string `json:"next_since"`
+	// Lines were left out because there were more than MaxLogLines.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// Logs reads the output of a container from the Docker logs API.
+func (self *Base) Logs(opts LogsOpts) (*LogsResult, error) {
+	var since time.Time
+	if opts.Since != "" {
+		var err error
+		since, err = time.Parse(time.RFC3339Nano, opts.Since)
+		if err != nil {
+			return nil, fmt.Errorf("since must be a RFC 3339 timestamp: %s", err)
+		}
+	}
+
+	lines := opts.Lines
+	if lines <= 0 {
+		lines = DefaultLogLines
+	}
+	if lines > MaxLogLines {
+		lines = MaxLogLines
+	}
+
+	wait := opts.Wait
+	if wait <= 0 {
+		wait = DefaultLogWait
+	}
+	if wait > MaxLogWait {
+		wait = MaxLogWait
+	}
+
+	container, err := self.DockerClient.InspectContainer(opts.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+
+	collector := &logCollector{since: since, max: MaxLogLines, arrived: make(chan bool, 1)}
+	logsOpts := docker.LogsOptions{
+		Container:    container.ID,
+		OutputStream: collector.stream(&collector.stdout),
+		ErrorStream:  collector.stream(&collector.stderr),
+		Stdout:       true,
+		Stderr:       true,
+		Timestamps:   true,
+		Tail:         fmt.Sprint(lines),
+		RawTerminal:  container.Config != nil && container.Config.Tty,
+	}
+	if !since.IsZero() {
+		logsOpts.Tail = "all"
+		// Docker only takes whole seconds, the collector drops the lines of
+		// that second the caller has seen.
+		logsOpts.Since = since.Unix()
+	}
+
+	// Without follow Docker returns once it sent all lines.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(wait)*time.Second)
+	defer cancel()
+	logsOpts.Context = ctx
+	logsOpts.Follow = opts.Follow
+
+	done := make(chan error, 1)
+	go func() {
+		done <- self.DockerClient.Logs(logsOpts)
+	}()
+
+	if opts.Follow {
+		select {
+		case err = <-done:
+		case <-collector.arrived:
+			time.Sleep(logBatchDelay)
+			cancel()
+			err = <-done
+		case <-ctx.Done():
+			err = <-done
+		}
+	} else {
+		err = <-done
+	}
+	if err != nil && ctx.Err() == nil {
+		return nil, err
+	}
+
+	return collector.result(opts.Since), nil
+}
+
+// logCollector splits the log streams into timestamped lines.
+type logCollector struct {
+	since   time.Time
+	max     int
+	arrived chan bool
+
+	lock      sync.Mutex
+	stdout    []LogLine
+	stderr    []LogLine
+	last      time.Time
+	truncated bool
+}
+
+func (self *logCollector) stream(lines *[]LogLine) *logStream {
+	return &logStream{collector: self, lines: lines}
+}
+
+func (self *logCollector) add(lines *[]LogLine, raw string) {
+	// Docker puts the timestamp in front when asked to.
+	parts := strings.SplitN(raw, " ", 2)
+	line := LogLine{Text: raw}
+	if t, err := time.Parse(time.RFC3339Nano, parts[0]); err == nil {
+		line.Time = t
+		line.Text = ""
+		if len(parts) == 2 {
+			line.Text = parts[1]
+		}
+	}
+	if !self.since.IsZero() && !line.Time.After(self.since) {
+		return
+	}
+
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	if line.Time.After(self.last) {
+		self.last = line.Time
+	}
+	*lines = append(*lines, line)
+	if len(*lines) > self.max {
+		*lines = (*lines)[len(*lines)-self.max:]
+		self.truncated = true
+	}
+
+	select {
+	case self.arrived <- true:
+	default:
+	}
+}
+
+// result returns the collected lines, since is passed on when there are
+// none.
+func (self *logCollector) result(since string) *LogsResult {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	res := &LogsResult{Stdout: self.stdout, Stderr: self.stderr, NextSince: since, Truncated: self.truncated}
+	if res.Stdout == nil {
+		res.Stdout = []LogLine{}
+	}
+	if res.Stderr == nil {
+		res.Stderr = []LogLine{}
+	}
+	if !self.last.IsZero() {
+		res.NextSince = self.last.Format(time.RFC3339Nano)
+	}
+	return res
+}
+
+// logStream buffers a stream until whole lines are written.
+type logStream struct {
+	collector *logCollector
+	lines     *[]LogLine
+	buffer    bytes.Buffer
+}
+
+func (self *logStream) Write(data []byte) (int, error) {
+	self.buffer.Write(data)
+	for {
+		i := bytes.IndexByte(self.buffer.Bytes(), '\n')
+		if i < 0 {
+			break
+		}
+		line := string(self.buffer.Next(i + 1))
+		self.collector.add(self.lines, strings.TrimRight(line, "\r\n"))
+	}
+	return len(data), nil
+}
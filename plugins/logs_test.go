@@ -0,0 +1,27 @@
+package plugins
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLogCollector(t *testing.T) {
+	since, _ := time.Parse(time.RFC3339Nano, "2017-01-01T10:00:00.5Z")
+	collector := &logCollector{since: since, max: 2, arrived: make(chan bool, 1)}
+	stdout := collector.stream(&collector.stdout)
+
+	stdout.Write([]byte("2017-01-01T10:00:00.4Z seen before\n2017-01-01T10:00:01Z first\n2017-01-01T10:00:02Z sec"))
+	stdout.Write([]byte("ond\r\n2017-01-01T10:00:03Z third\n"))
+	collector.stream(&collector.stderr).Write([]byte("2017-01-01T10:00:04Z oops\n"))
+
+	res := collector.result("")
+	if len(res.Stdout) != 2 || res.Stdout[0].Text != "second" || res.Stdout[1].Text != "third" || !res.Truncated {
+		t.Errorf("Expected the last two new lines on stdout, got: %+v", res)
+	}
+	if len(res.Stderr) != 1 || res.Stderr[0].Text != "oops" {
+		t.Errorf("Expected stderr to be kept apart, got: %+v", res.Stderr)
+	}
+	if res.NextSince != "2017-01-01T10:00:04Z" {
+		t.Errorf("Expected the time of the last line as next since, got '%s'", res.NextSince)
+	}
+}
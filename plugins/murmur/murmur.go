@@ -12,6 +12,16 @@ type Murmur struct {
 	imageName string
 }
 
+func init() {
+	plugins.Register("murmur", func(client *docker.Client) (plugins.Plugin, error) {
+		p, err := New(client)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
 func New(client *docker.Client) (*Murmur, error) {
 	manifest, err := plugins.LoadManifest("murmur")
 
@@ -51,7 +61,7 @@ func (self *Murmur) Install(opts *MurmurOpts) error {
 	}
 
 	log.Debugln("Pulling docker image", self.imageName)
-	err = self.DockerClient.PullImage(docker.PullImageOptions{Repository: self.imageName}, docker.AuthConfiguration{})
+	err = self.PullImage(self.imageName, opts)
 	if err != nil {
 		return err
 	}
@@ -69,7 +79,7 @@ func (self *Murmur) Install(opts *MurmurOpts) error {
 	conf := docker.Config{Env: []string{"PUID=" + opts.User.Uid}, Image: self.imageName}
 
 	log.Debugln("Creating docker container")
-	c, err := self.DockerClient.CreateContainer(docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_murmur_" + opts.WebPort})
+	c, err := self.CreateContainer(opts, docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_murmur_" + opts.WebPort})
 
 	if err != nil {
 		return err
@@ -77,7 +87,7 @@ func (self *Murmur) Install(opts *MurmurOpts) error {
 
 	log.Debugln("Starting docker container")
 
-	err = self.DockerClient.StartContainer(c.ID, nil)
+	err = self.StartContainer(c.ID, opts)
 	if err != nil {
 		return err
 	}
@@ -86,3 +96,21 @@ func (self *Murmur) Install(opts *MurmurOpts) error {
 
 	return nil
 }
+
+// ChangePassword changes the password users need to join the server.
+func (self *Murmur) ChangePassword(containerId string, password string) error {
+	opts := &MurmurOpts{}
+	_, err := self.LoadOptions(containerId, opts)
+	if err != nil {
+		return err
+	}
+
+	opts.Password = password
+
+	err = self.RewriteTemplateLines("plugins/murmur/data/murmur.ini", opts.ConfigFolder+"/murmur.ini", opts, "serverpassword=")
+	if err != nil {
+		return err
+	}
+
+	return self.Restart(&plugins.AppConfig{ContainerId: containerId})
+}
@@ -13,6 +13,16 @@ type Nzbget struct {
 	imageName string
 }
 
+func init() {
+	plugins.Register("nzbget", func(client *docker.Client) (plugins.Plugin, error) {
+		p, err := New(client)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
 func New(client *docker.Client) (*Nzbget, error) {
 	manifest, err := plugins.LoadManifest("nzbget")
 
@@ -58,7 +68,7 @@ func (self *Nzbget) Install(opts *NzbgetOpts) error {
 	}
 
 	log.Debugln("Pulling docker image", self.imageName)
-	err = self.DockerClient.PullImage(docker.PullImageOptions{Repository: self.imageName}, docker.AuthConfiguration{})
+	err = self.PullImage(self.imageName, opts)
 	if err != nil {
 		return err
 	}
@@ -75,7 +85,7 @@ func (self *Nzbget) Install(opts *NzbgetOpts) error {
 	conf := docker.Config{Env: []string{"PUID=" + opts.User.Uid}, Image: self.imageName}
 
 	log.Debugln("Creating docker container")
-	c, err := self.DockerClient.CreateContainer(docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_nzbget_" + opts.WebPort})
+	c, err := self.CreateContainer(opts, docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_nzbget_" + opts.WebPort})
 
 	if err != nil {
 		return err
@@ -83,7 +93,7 @@ func (self *Nzbget) Install(opts *NzbgetOpts) error {
 
 	log.Debugln("Starting docker container")
 
-	err = self.DockerClient.StartContainer(c.ID, nil)
+	err = self.StartContainer(c.ID, opts)
 	if err != nil {
 		return err
 	}
@@ -92,3 +102,21 @@ func (self *Nzbget) Install(opts *NzbgetOpts) error {
 
 	return nil
 }
+
+// ChangePassword changes ControlPassword, the news server logins are kept.
+func (self *Nzbget) ChangePassword(containerId string, password string) error {
+	opts := &NzbgetOpts{}
+	_, err := self.LoadOptions(containerId, opts)
+	if err != nil {
+		return err
+	}
+
+	opts.Password = password
+
+	err = self.RewriteTemplateLines("plugins/nzbget/data/nzbget.conf", opts.ConfigFolder+"/nzbget.conf", opts, "ControlPassword=")
+	if err != nil {
+		return err
+	}
+
+	return self.Restart(&plugins.AppConfig{ContainerId: containerId})
+}
@@ -0,0 +1,148 @@
+package plugins
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/ghodss/yaml"
+)
+
+// OverlayManifest deep-merges a custom manifest onto a built-in one. Maps,
+// like resources, are merged key by key at any depth, other fields in the
+// overlay replace those of the base, lists included, and a null value
+// removes them. Method options are merged by name: listed options are added
+// or updated key by key, options with `remove: true` are dropped and a
+// method set to null loses all of its options.
+func OverlayManifest(base []byte, overlay []byte) (*Manifest, error) {
+	baseMap, err := yamlMap(base)
+	if err != nil {
+		return nil, err
+	}
+	overlayMap, err := yamlMap(overlay)
+	if err != nil {
+		return nil, err
+	}
+
+	methods, hasMethods := overlayMap["method_options"]
+	delete(overlayMap, "method_options")
+	if hasMethods {
+		if methods == nil {
+			delete(baseMap, "method_options")
+		} else {
+			baseMap["method_options"], err = mergeMethodOptions(baseMap["method_options"], methods)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	mergeMaps(baseMap, overlayMap)
+
+	// Going through YAML again lets the decoder turn numbers into strings
+	// where the manifest expects them, like it does for the files.
+	data, err := yaml.Marshal(baseMap)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := Manifest{}
+	err = yaml.Unmarshal(data, &manifest)
+	if err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+func yamlMap(data []byte) (map[string]interface{}, error) {
+	res := map[string]interface{}{}
+	data, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return nil, err
+	}
+	// An empty document decodes to null.
+	if res == nil {
+		res = map[string]interface{}{}
+	}
+	return res, nil
+}
+
+// mergeMaps merges overlay into base, nested maps are merged as well.
+func mergeMaps(base map[string]interface{}, overlay map[string]interface{}) {
+	for key, value := range overlay {
+		baseChild, baseIsMap := base[key].(map[string]interface{})
+		overlayChild, overlayIsMap := value.(map[string]interface{})
+		switch {
+		case value == nil:
+			delete(base, key)
+		case baseIsMap && overlayIsMap:
+			mergeMaps(baseChild, overlayChild)
+		default:
+			base[key] = value
+		}
+	}
+}
+
+func mergeMethodOptions(base interface{}, overlay interface{}) (map[string]interface{}, error) {
+	baseMethods, _ := base.(map[string]interface{})
+	if baseMethods == nil {
+		baseMethods = map[string]interface{}{}
+	}
+	overlayMethods, ok := overlay.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("method_options must map method names to lists of options")
+	}
+
+	for method, value := range overlayMethods {
+		if value == nil {
+			delete(baseMethods, method)
+			continue
+		}
+
+		overlayOptions, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("The options of method '%s' must be a list", method)
+		}
+		baseOptions, _ := baseMethods[method].([]interface{})
+
+		for _, o := range overlayOptions {
+			option, ok := o.(map[string]interface{})
+			name, _ := option["name"].(string)
+			if !ok || name == "" {
+				return nil, fmt.Errorf("Every option of method '%s' needs a name", method)
+			}
+			baseOptions = mergeOption(baseOptions, name, option)
+		}
+		baseMethods[method] = baseOptions
+	}
+	return baseMethods, nil
+}
+
+func mergeOption(options []interface{}, name string, overlay map[string]interface{}) []interface{} {
+	remove, _ := overlay["remove"].(bool)
+	delete(overlay, "remove")
+
+	for i, o := range options {
+		option, _ := o.(map[string]interface{})
+		if option == nil || option["name"] != name {
+			continue
+		}
+		if remove {
+			return append(options[:i], options[i+1:]...)
+		}
+		for key, value := range overlay {
+			if value == nil {
+				delete(option, key)
+			} else {
+				option[key] = value
+			}
+		}
+		return options
+	}
+
+	if remove {
+		return options
+	}
+	return append(options, overlay)
+}
@@ -0,0 +1,75 @@
+package plugins
+
+import (
+	"testing"
+)
+
+func TestOverlayManifest(t *testing.T) {
+	builtin, err := Asset("plugins/deluge/data/manifest.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	overlay := `
+description: "Deluge for this host"
+show_options:
+method_options:
+  Install:
+  - name: web_port
+    default_value: "8112"
+  - name: media_folder
+    remove: true
+  - name: label
+    type: string
+  Stop:
+`
+	m, err := OverlayManifest(builtin, []byte(overlay))
+	if err != nil {
+		t.Fatal("Could not merge manifests:", err)
+	}
+
+	if m.Name != "Deluge" || m.Description != "Deluge for this host" || m.ShowOptions != nil {
+		t.Error("Top level fields were not merged:", m.Name, m.Description, m.ShowOptions)
+	}
+	if _, ok := m.MethodOptions["Stop"]; ok || len(m.MethodOptions["Start"]) != 1 {
+		t.Error("Methods were not merged:", m.MethodOptions)
+	}
+
+	options := map[string]MethodOption{}
+	for _, option := range m.MethodOptions["Install"] {
+		options[option.Name] = option
+	}
+	if options["web_port"].DefaultValue != "8112" || options["web_port"].Type != TypePort {
+		t.Error("Option was not updated key by key:", options["web_port"])
+	}
+	if _, ok := options["media_folder"]; ok {
+		t.Error("Removed option is still there")
+	}
+	if _, ok := options["label"]; !ok || len(options) != 7 {
+		t.Error("Expected one option to be added and one removed:", options)
+	}
+}
+
+func TestOverlayManifestMergesNestedMaps(t *testing.T) {
+	builtin, err := Asset("plugins/plex/data/manifest.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	overlay := `
+resources:
+  memory_limit: 1g
+  pids_limit:
+`
+	m, err := OverlayManifest(builtin, []byte(overlay))
+	if err != nil {
+		t.Fatal("Could not merge manifests:", err)
+	}
+
+	if m.Resources == nil || m.Resources.MemoryLimit != "1g" || m.Resources.CpuShares != "512" || m.Resources.PidsLimit != "" {
+		t.Error("Resources were not merged key by key:", m.Resources)
+	}
+	if len(m.MethodOptions["Install"]) == 0 {
+		t.Error("Method options should be left alone")
+	}
+}
@@ -0,0 +1,214 @@
+package plugins
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	log "github.com/Sirupsen/logrus"
+	"github.com/fsouza/go-dockerclient"
+	"io/ioutil"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// PasswordOpts selects the app to change the password of.
+type PasswordOpts struct {
+	ContainerId string `json:"container_id"`
+	Password    string `json:"password"`
+}
+
+// ChangePassword is the answer of apps that keep their credentials where
+// bcd can not change them, plugins that can override it.
+func (self *Base) ChangePassword(containerId string, password string) error {
+	return fmt.Errorf("%s does not support changing the password, change it in the app itself", self.Name)
+}
+
+// LoadOptions fills opts with the options a container was installed with.
+// Secrets are not kept on the container, they stay empty.
+func (self *Base) LoadOptions(containerId string, opts Options) (*docker.Container, error) {
+	container, err := self.DockerClient.InspectContainer(containerId)
+	if err != nil {
+		return nil, err
+	}
+	if container.Config == nil {
+		return nil, fmt.Errorf("Container %s is not a %s app", containerId, self.Name)
+	}
+
+	// Apps installed before bcd labeled its containers are known by name.
+	if _, labeled := container.Config.Labels[LabelPlugin]; !labeled {
+		if !strings.HasPrefix(strings.TrimPrefix(container.Name, "/"), ContainerPrefix+self.Name+"_") {
+			return nil, fmt.Errorf("Container %s is not a %s app", containerId, self.Name)
+		}
+		err = self.unlabeledOptions(container, opts)
+		if err != nil {
+			return nil, fmt.Errorf("Could not work out the options %s was installed with: %s", containerId, err)
+		}
+		return container, nil
+	}
+	if container.Config.Labels[LabelPlugin] != self.Name {
+		return nil, fmt.Errorf("Container %s is not a %s app", containerId, self.Name)
+	}
+
+	err = json.Unmarshal([]byte(container.Config.Labels[LabelOptions]), opts)
+	if err != nil {
+		return nil, fmt.Errorf("Could not read the options %s was installed with: %s", containerId, err)
+	}
+	return container, nil
+}
+
+// unlabeledOptions fills opts for a container without the options label from
+// the defaults of the manifest, the folders the container binds and its web
+// port.
+func (self *Base) unlabeledOptions(container *docker.Container, opts Options) error {
+	options := map[string]string{}
+	if self.Manifest != nil {
+		for _, option := range self.Manifest.MethodOptions["Install"] {
+			if option.DefaultValue != "" {
+				options[option.Name] = option.DefaultValue
+			}
+		}
+	}
+
+	if container.HostConfig != nil {
+		folders := map[string]string{"/config": "config_folder", "/data": "data_folder", "/media": "media_folder"}
+		for _, bind := range container.HostConfig.Binds {
+			parts := strings.Split(bind, ":")
+			if len(parts) >= 2 && folders[parts[1]] != "" {
+				options[folders[parts[1]]] = parts[0]
+			}
+		}
+	}
+	if port := webPort(container); port != "" {
+		options["web_port"] = port
+	}
+
+	data, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, opts)
+}
+
+// RewriteTemplateLines renders a template and copies the lines starting
+// with the given keys into the existing file, the rest of the file is left
+// alone. A key can be limited to an ini section by putting the section in
+// front, as in "[core]password =".
+func (s *Base) RewriteTemplateLines(templateName string, outputFile string, object Options, keys ...string) error {
+	if object.GetBaseOpts().NoTemplates == "true" {
+		return fmt.Errorf("%s was installed with no_templates, bcd does not manage its config files", s.Name)
+	}
+
+	data, err := Asset(templateName)
+	if err != nil {
+		return err
+	}
+
+	return s.rewriteTemplateLines(templateName, data, outputFile, object, keys)
+}
+
+// RewriteTemplateFileLines works like RewriteTemplateLines but reads the
+// template from disk instead of the embedded assets.
+func (s *Base) RewriteTemplateFileLines(templatePath string, outputFile string, object Options, keys ...string) error {
+	if object.GetBaseOpts().NoTemplates == "true" {
+		return fmt.Errorf("%s was installed with no_templates, bcd does not manage its config files", s.Name)
+	}
+
+	data, err := ioutil.ReadFile(templatePath)
+	if err != nil {
+		return err
+	}
+
+	return s.rewriteTemplateLines(templatePath, data, outputFile, object, keys)
+}
+
+func (s *Base) rewriteTemplateLines(templateName string, data []byte, outputFile string, object Options, keys []string) error {
+	tmpl, err := template.New(templateName).Parse(string(data))
+	if err != nil {
+		return err
+	}
+	rendered := bytes.Buffer{}
+	err = tmpl.Execute(&rendered, object)
+	if err != nil {
+		return err
+	}
+
+	lines := strings.Split(rendered.String(), "\n")
+	replacements := map[string]string{}
+	for _, key := range keys {
+		i := findLine(lines, key)
+		if i < 0 {
+			return fmt.Errorf("Template %s has no line for %s", templateName, key)
+		}
+		replacements[key] = lines[i]
+	}
+
+	return ReplaceLines(outputFile, replacements)
+}
+
+// ReplaceLines replaces the first line of a file starting with each key, see
+// RewriteTemplateLines, with the given line. The indentation of the file is
+// kept. The old file is kept as a back-up.
+func ReplaceLines(file string, replacements map[string]string) error {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	lines := strings.Split(string(data), "\n")
+	for key, replacement := range replacements {
+		i := findLine(lines, key)
+		if i < 0 {
+			return fmt.Errorf("Could not find %s in %s", key, file)
+		}
+		indent := lines[i][:len(lines[i])-len(strings.TrimLeft(lines[i], " \t"))]
+		lines[i] = indent + strings.TrimSpace(replacement)
+	}
+
+	log.Debugf("Rewriting %d lines of %s", len(replacements), file)
+	return ReplaceFile(file, []byte(strings.Join(lines, "\n")))
+}
+
+// ReplaceFile writes data over an existing file, the old contents are kept
+// in a back-up next to it. The file is written in place so the app keeps
+// owning it.
+func ReplaceFile(file string, data []byte) error {
+	info, err := os.Stat(file)
+	if err != nil {
+		return err
+	}
+	old, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	err = ioutil.WriteFile(fmt.Sprintf("%s.backup", file), old, info.Mode())
+	if err != nil {
+		log.Warningln("Could not back up", file, "rewriting it anyway. Error:", err)
+	}
+	return ioutil.WriteFile(file, data, info.Mode())
+}
+
+// findLine returns the index of the first line starting with key, ignoring
+// indentation, or -1.
+func findLine(lines []string, key string) int {
+	section := ""
+	if strings.HasPrefix(key, "[") {
+		if end := strings.Index(key, "]"); end > 0 {
+			section, key = key[:end+1], key[end+1:]
+		}
+	}
+
+	current := ""
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if section != "" && strings.HasPrefix(line, "[") {
+			current = line
+			continue
+		}
+		if current == section && strings.HasPrefix(line, key) {
+			return i
+		}
+	}
+	return -1
+}
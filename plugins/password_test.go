@@ -0,0 +1,76 @@
+package plugins
+
+import (
+	"bytes"
+	"github.com/fsouza/go-dockerclient"
+	"io/ioutil"
+	"log"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadOptionsOfUnlabeledContainer(t *testing.T) {
+	// Installed before bcd labeled its containers.
+	fake := &fakeDocker{replies: map[string]string{
+		"GET /containers/old/json": `{"Id": "old", "Name": "/bytesized_deluge_8112", "Config": {"Image": "bytesized/deluge"},
+			"HostConfig": {"Binds": ["/home/jan/config/deluge:/config", "/home/jan/data:/data:rw"],
+			"PortBindings": {"8112/tcp": [{"HostPort": "8112"}]}}}`,
+		"GET /containers/other/json": `{"Id": "other", "Name": "/bytesized_plex_32400", "Config": {}}`,
+	}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client, err := docker.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifest := &Manifest{MethodOptions: map[string][]MethodOption{"Install": {
+		{Name: "username", DefaultValue: "bytesized"},
+		{Name: "config_folder", DefaultValue: "/home/bytesized/config/deluge"},
+	}}}
+	b := Base{DockerClient: client, Name: "deluge", Manifest: manifest}
+
+	opts := &BaseOpts{}
+	_, err = b.LoadOptions("old", opts)
+	if err != nil {
+		t.Fatal("Could not load the options:", err)
+	}
+	if opts.ConfigFolder != "/home/jan/config/deluge" || opts.DataFolder != "/home/jan/data" || opts.WebPort != "8112" || opts.Username != "bytesized" {
+		t.Errorf("Options were not taken from the container and the manifest: %+v", opts)
+	}
+
+	if _, err = b.LoadOptions("other", &BaseOpts{}); err == nil {
+		t.Error("Expected an error for a container of another plugin")
+	}
+}
+
+func TestRewriteTemplateLines(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bcdtest")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "config.ini")
+	ioutil.WriteFile(file, []byte("[General]\n    http_port = 8181\nhttp_password = old\n[Other]\nhttp_password = other\n"), 0640)
+
+	b := Base{}
+	err = b.RewriteTemplateLines("plugins/plexpy/data/config.ini", file, &BaseOpts{Password: "n3w"}, "[General]http_password =")
+	if err != nil {
+		t.Fatal("Could not rewrite the password:", err)
+	}
+	data, _ := ioutil.ReadFile(file)
+	if string(data) != "[General]\n    http_port = 8181\nhttp_password = n3w\n[Other]\nhttp_password = other\n" {
+		t.Errorf("Expected only the password of the section to change, got:\n%s", data)
+	}
+	if backup, _ := ioutil.ReadFile(file + ".backup"); !bytes.Contains(backup, []byte("= old")) {
+		t.Error("Expected the old file to be backed up")
+	}
+
+	err = b.RewriteTemplateLines("plugins/plexpy/data/config.ini", file, &BaseOpts{Password: "n3w"}, "[Missing]http_password =")
+	if err == nil {
+		t.Error("Expected an error for a line the file does not have")
+	}
+}
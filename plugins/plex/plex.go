@@ -18,6 +18,16 @@ type PlexOpts struct {
 	PlexPass  string `json:"plex_pass"`
 }
 
+func init() {
+	plugins.Register("plex", func(client *docker.Client) (plugins.Plugin, error) {
+		p, err := New(client)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
 func New(client *docker.Client) (*Plex, error) {
 	manifest, err := plugins.LoadManifest("plex")
 	if err != nil {
@@ -56,7 +66,7 @@ func (self *Plex) Install(opts *PlexOpts) error {
 	}).Debug("Plex options")
 
 	log.Debugln("Pulling docker image:", dockerImage)
-	err = self.DockerClient.PullImage(docker.PullImageOptions{Repository: dockerImage}, docker.AuthConfiguration{})
+	err = self.PullImage(dockerImage, opts)
 	if err != nil {
 		return err
 	}
@@ -68,7 +78,7 @@ func (self *Plex) Install(opts *PlexOpts) error {
 
 	log.Infoln("Creating docker container")
 	conf := docker.Config{Env: []string{"PLEX_UID=" + opts.User.Uid, "PLEX_GID=" + opts.User.Gid, "PLEX_CLAIM=" + opts.PlexClaim}, Image: dockerImage}
-	c, err := self.DockerClient.CreateContainer(docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_plex_" + opts.WebPort})
+	c, err := self.CreateContainer(opts, docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_plex_" + opts.WebPort})
 
 	if err != nil {
 		return err
@@ -76,7 +86,7 @@ func (self *Plex) Install(opts *PlexOpts) error {
 	opts.ContainerId = c.ID
 
 	log.Infoln("Starting docker container")
-	err = self.DockerClient.StartContainer(c.ID, nil)
+	err = self.StartContainer(c.ID, opts)
 	if err != nil {
 		return err
 	}
@@ -13,6 +13,16 @@ type Plexpy struct {
 	imageName string
 }
 
+func init() {
+	plugins.Register("plexpy", func(client *docker.Client) (plugins.Plugin, error) {
+		p, err := New(client)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
 func New(client *docker.Client) (*Plexpy, error) {
 	manifest, err := plugins.LoadManifest("plexpy")
 
@@ -57,7 +67,7 @@ func (self *Plexpy) Install(opts *PlexpyOpts) error {
 	}
 
 	log.Debugln("Pulling docker image", self.imageName)
-	err = self.DockerClient.PullImage(docker.PullImageOptions{Repository: self.imageName}, docker.AuthConfiguration{})
+	err = self.PullImage(self.imageName, opts)
 	if err != nil {
 		return err
 	}
@@ -76,7 +86,7 @@ func (self *Plexpy) Install(opts *PlexpyOpts) error {
 	conf := docker.Config{Env: []string{"PUID=" + opts.User.Uid}, Image: self.imageName}
 
 	log.Debugln("Creating docker container")
-	c, err := self.DockerClient.CreateContainer(docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_plexpy_" + opts.WebPort})
+	c, err := self.CreateContainer(opts, docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_plexpy_" + opts.WebPort})
 
 	if err != nil {
 		return err
@@ -84,7 +94,7 @@ func (self *Plexpy) Install(opts *PlexpyOpts) error {
 
 	log.Debugln("Starting docker container")
 
-	err = self.DockerClient.StartContainer(c.ID, nil)
+	err = self.StartContainer(c.ID, opts)
 	if err != nil {
 		return err
 	}
@@ -93,3 +103,21 @@ func (self *Plexpy) Install(opts *PlexpyOpts) error {
 
 	return nil
 }
+
+// ChangePassword changes the login of the web interface.
+func (self *Plexpy) ChangePassword(containerId string, password string) error {
+	opts := &PlexpyOpts{}
+	_, err := self.LoadOptions(containerId, opts)
+	if err != nil {
+		return err
+	}
+
+	opts.Password = password
+
+	err = self.RewriteTemplateLines("plugins/plexpy/data/config.ini", opts.ConfigFolder+"/config.ini", opts, "http_password =")
+	if err != nil {
+		return err
+	}
+
+	return self.Restart(&plugins.AppConfig{ContainerId: containerId})
+}
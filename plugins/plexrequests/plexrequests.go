@@ -13,6 +13,16 @@ type Plexrequests struct {
 	imageName string
 }
 
+func init() {
+	plugins.Register("plexrequests", func(client *docker.Client) (plugins.Plugin, error) {
+		p, err := New(client)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
 func New(client *docker.Client) (*Plexrequests, error) {
 	manifest, err := plugins.LoadManifest("plexrequests")
 
@@ -52,7 +62,7 @@ func (self *Plexrequests) Install(opts *PlexrequestsOpts) error {
 	}
 
 	log.Debugln("Pulling docker image", self.imageName)
-	err = self.DockerClient.PullImage(docker.PullImageOptions{Repository: self.imageName}, docker.AuthConfiguration{})
+	err = self.PullImage(self.imageName, opts)
 	if err != nil {
 		return err
 	}
@@ -69,7 +79,7 @@ func (self *Plexrequests) Install(opts *PlexrequestsOpts) error {
 	conf := docker.Config{Env: []string{"PUID=" + opts.User.Uid}, Image: self.imageName}
 
 	log.Debugln("Creating docker container")
-	c, err := self.DockerClient.CreateContainer(docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_plexrequests_" + opts.WebPort})
+	c, err := self.CreateContainer(opts, docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_plexrequests_" + opts.WebPort})
 
 	if err != nil {
 		return err
@@ -77,7 +87,7 @@ func (self *Plexrequests) Install(opts *PlexrequestsOpts) error {
 
 	log.Debugln("Starting docker container")
 
-	err = self.DockerClient.StartContainer(c.ID, nil)
+	err = self.StartContainer(c.ID, opts)
 	if err != nil {
 		return err
 	}
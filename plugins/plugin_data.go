@@ -128,7 +128,7 @@ func pluginsCardigannDataConfigJson() (*asset, error) {
 	return a, nil
 }
 
-var _pluginsCardigannDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x92\x3d\x8e\xdc\x30\x0c\x85\x7b\x9d\x82\xb0\xdb\x38\x46\x5a\xb5\x5b\x6d\xaa\x60\xe7\x00\x02\xc7\xa2\xc7\xc2\xca\xa2\x40\xd1\xe3\x4c\x4e\x1f\xf8\x67\x7e\x10\x18\xa9\x92\xca\x16\xf5\xf1\x3d\x89\x7a\xf4\x33\x73\x21\xef\x46\xd2\x81\x7d\xb1\xa6\x81\xf7\x54\x14\x63\x34\x0d\x7c\x50\x51\x14\x35\x0d\x9c\x94\xf3\xfa\x59\x96\x1b\xeb\x38\x6b\xe0\x54\xac\x81\x7b\xcb\xf2\xdb\x80\xa7\x1e\xa7\xa8\xee\x8a\x71\x22\x0b\xed\xc0\x23\xb5\xe7\x9b\x52\x09\xbf\xc8\xb7\x1d\xa7\x3e\x5c\xda\x0e\xc5\x87\x0b\xa6\x64\x00\x00\x12\x8e\x64\x61\xdb\x72\x3d\x47\x4f\xb2\xd6\xf5\x96\xc9\x42\x51\x09\xe9\xb2\x16\x30\x46\x9e\x9d\xa7\x48\x8b\xb9\x05\x95\x89\x0e\x5c\x57\x76\x08\x49\x2d\x54\xd5\x8b\x43\xc6\x52\x66\x16\x7f\x2c\xbe\x37\xbc\xf7\x70\xe3\x09\x22\xe1\x95\x40\x87\x50\x80\xc6\xac\x37\x40\x10\x4c\x9e\xc7\x87\x0a\xcc\x21\x46\x38\x13\x14\x8a\xd4\x29\x79\xe8\x59\x96\xde\xea\xef\x47\x3a\xad\x38\x20\xf4\x42\x04\x99\x45\x41\x19\x64\x4a\x9b\x1b\xa7\x2f\xbb\xf9\xe6\xab\x0c\xc3\xb2\xc2\x0d\xcd\xa1\xfb\x7c\x5a\x7d\x7d\xb9\xdd\x4c\x67\xb7\x20\x47\xb7\xdb\xdf\xf2\xf0\x89\xf6\x11\x1d\xcc\xab\xe3\xa4\x18\x12\x89\x0b\x87\x33\x3b\xfd\x17\x4d\xce\xff\x54\x72\x67\x1e\x81\x93\xdc\xb9\xad\xf6\x76\xaf\x7d\xfc\x78\x33\x65\xe0\xf9\x19\xea\x06\xa6\x42\xb2\x60\xa6\x79\xc6\xa6\x79\xce\xb8\xf9\x23\xae\x57\x92\xb2\x46\xf2\x9b\x59\x98\x49\xa2\xeb\x59\x46\x54\x0b\x83\x6a\xb6\x6d\x5b\xd7\x21\xd7\xb5\xad\xeb\xbb\x46\x5d\xb7\xc6\x53\xe9\x24\xe4\x2d\xcd\xd5\xe3\x40\x10\x0a\x20\x9c\x49\x95\x04\xbe\x63\xf7\x49\xaa\x95\xf9\x1d\x00\x00\xff\xff\xdd\xc3\xcf\x0d\xae\x03\x00\x00"
+var _pluginsCardigannDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x54\x3d\x8f\xdb\x30\x0c\xdd\xfd\x2b\x08\x7b\x6d\x6a\x74\xf5\x9a\xe9\x3a\x1d\x2e\xed\x6c\x28\x16\x1d\x09\x95\x25\x1d\x45\x3b\x4d\x7f\x7d\x69\x2b\x71\x3e\xd0\x00\x37\x5c\x27\x53\x8f\xe4\x7b\xb4\x48\x0a\x7f\xc7\x90\x50\xb7\x03\xb2\x09\x3a\x35\xc5\x06\x5e\x7c\x62\xe5\x9c\x58\x6f\x28\x16\xb1\x58\x3f\xe3\x81\x94\xc6\xc5\xd2\x8a\x51\x3c\x61\xa4\x0e\x93\x20\x5b\xa3\xfc\x01\x5f\x55\x4a\xc7\x40\x5a\x80\x1d\x87\xb8\x7c\xe6\x5c\x83\xca\xb1\x99\x79\xf9\x14\xb1\x01\xc3\x1c\x0b\x80\x10\xd9\x06\xdf\xc0\x11\xf7\x6d\x0c\x12\x97\x0b\x68\x33\x2e\x75\xc0\xa5\x8e\xd9\xdc\x80\xc6\x5e\x8d\x8e\xdb\x49\xb9\x51\x58\x6a\x13\x06\xac\xf7\x27\xc6\x64\xff\xa0\xae\xbb\xe0\x7b\x7b\xa8\x3b\x45\xda\x1e\x94\xf7\x92\x03\xe0\xd5\x20\xa1\xd9\xd5\xf6\xc1\x69\xa4\x05\xcf\x85\x44\xc5\x66\x39\x8a\x46\x38\xb6\x1a\x1d\xe6\x92\x98\x46\xfc\x88\xe6\x80\xda\xaa\x9a\xa7\x1b\x2d\x9e\x9e\xea\x3c\xd2\xdd\x64\xc5\xcb\xd5\x5d\x93\x12\x76\x84\xbc\x00\xc6\x7a\x6e\xa0\x7c\xe9\xe1\x14\x46\x70\xa8\x26\x04\x36\x36\x01\x0e\x91\x4f\xa0\x80\x94\xd7\x61\x58\x59\xe0\x68\x9d\x83\x3d\x0a\x87\xc3\x8e\x51\x43\x1f\x68\xce\x2d\x9f\x55\x91\x15\x76\x4b\xb8\xf0\xf5\x84\x08\x73\x4f\x80\x03\xd0\xe8\xb3\x5a\xf0\x5f\xce\xe2\x59\x57\x7c\x66\x3e\xa9\x1c\x1a\x6d\xf7\xeb\x2a\xf5\xf5\xe6\xef\xd6\x0e\xdf\x5c\x49\x3e\x9e\xc7\xeb\x9f\x0d\x2e\xcb\xdb\x9f\x2f\xef\xfb\xc9\xca\x7a\xa4\xd6\xde\xdd\x18\x93\xf5\x07\x01\x76\xff\x85\x33\xc4\x4f\xa6\xbc\xdf\x9a\x4f\x26\x07\x20\x7c\x1f\x2d\xa1\x7e\x3e\xce\xf7\xec\x3f\x0c\x82\xc7\xe3\x3a\x45\xe5\x87\xe7\xf3\x41\xe9\x5c\xe0\xba\x88\x14\xbb\x36\x63\xdb\x0b\xf6\xf6\xba\x2d\x92\x91\x9d\x5b\x97\x7d\x03\x63\x42\x9a\xc3\xc4\x8c\xd7\x97\x64\x9d\x9d\xcd\xc3\x1a\x4f\x48\x69\x59\xd6\x6f\xc5\x1c\x33\x92\x13\x0f\x0d\x8a\xf3\x03\xd3\xd4\x75\x55\xd9\x58\x55\x4d\x55\x5d\x38\xaa\xaa\x2e\x34\xa6\x8e\xec\xf9\xe9\x29\xd7\x82\x40\xe6\x5b\xc9\xca\x30\x23\xc1\x77\x25\x93\xcc\x5c\x16\x7f\x01\x92\x79\xfe\x08\x1b\x05\x00\x00"
 
 func pluginsCardigannDataManifestYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -143,12 +143,12 @@ func pluginsCardigannDataManifestYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "plugins/cardigann/data/manifest.yml", size: 942, mode: os.FileMode(493), modTime: time.Unix(1498116054, 0)}
+	info := bindataFileInfo{name: "plugins/cardigann/data/manifest.yml", size: 1307, mode: os.FileMode(493), modTime: time.Unix(1792320949, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _pluginsCouchpotatoDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x54\x4d\x8f\x9c\x30\x0c\xbd\xf3\x2b\xac\xe1\x5a\x8a\x7a\xe5\x56\x6d\x2f\x2b\x75\xa5\x6a\xb7\xed\x15\x19\x62\x26\x51\x43\x1c\x39\x66\xe8\xf4\xd7\x57\x81\xdd\x81\x56\xa8\x1f\x52\x7b\x1a\xc8\xd8\xcf\x2f\xef\xf9\x41\x5f\x23\x27\x32\xed\x48\x6a\xd9\xa4\xa6\xa8\xe0\x3e\x24\x45\xef\x8b\x0a\x1e\x29\x29\x8a\x16\x15\x3c\x29\xc7\xe5\x27\xbf\xae\xb5\x2d\x47\x75\x1c\x52\x53\xc0\x4b\x4b\x7e\xac\xc0\xd0\x80\x93\xd7\xf6\x82\x7e\xa2\x06\xba\xab\x52\x72\xdf\xc8\x14\x00\x00\xd6\x05\x6d\xe0\xf4\xd1\x12\x4c\x89\x24\xe0\x48\xa0\x0c\x9e\xcf\x2e\xe4\x07\xb5\x04\x33\x75\x95\x0b\x4a\x32\x60\x4f\xa7\xa5\x2d\xd7\x35\xb7\x8e\xe5\x48\xaf\x91\x1a\x48\x2a\x2e\x9c\x0f\xe6\xee\xa7\xed\x31\x22\xa6\x34\xb3\x98\x23\x8c\x5b\xc3\xfd\x00\x57\x9e\xc0\x13\x5e\x08\xd4\xba\x04\x34\x46\xbd\x02\x82\x60\x30\x3c\xde\x50\x60\x76\xde\x43\x47\x90\xc8\x53\xaf\x64\x60\x60\xc9\xbd\xa7\x23\x29\x6a\xcb\x23\xd5\x37\x41\xea\x9e\xc3\xe0\xce\x75\xcf\x53\x6f\x23\x2b\x2a\xef\x88\xae\x7f\xb6\x03\x7b\x43\x72\xcc\x16\xbd\xe7\xb9\x35\xe4\x29\x3b\xd1\x80\xca\x44\x7f\x32\xd7\xa0\xe2\xee\xba\xef\x50\x71\xbb\x88\xb2\x90\x01\x4b\x42\xaf\x77\x6c\x72\xcb\x2f\xb8\xfc\x76\xe4\x48\xc6\x61\xbd\x1b\xfa\x29\x91\x81\xd9\x52\x58\x94\x9e\x31\x68\xb6\x9f\x02\x76\x9e\x20\x72\xd2\x2a\x0a\xf7\x94\x92\x0b\xe7\x45\xd4\x63\x95\x16\xdc\xbf\x20\xb6\x63\xf0\xb4\x58\x06\x08\x83\x50\x1e\x29\x0b\x03\x99\xc2\xea\x38\x87\x57\xcf\x0b\xb0\x7a\xaf\x0c\x36\xbf\xe1\x5a\x1a\x5d\xff\x65\xb3\x7b\x2f\xd5\x4c\x5d\x9b\x4b\x8e\xe8\x3c\x27\xea\x30\x28\xcf\x6b\x7a\xb0\xb3\x3d\x07\x45\x17\x48\x5a\x77\xb8\xb7\x4f\xff\x05\x93\xe3\x3f\x85\x7c\xa9\xd9\x5c\x94\xd8\xb7\xeb\xe9\xdd\x76\xfa\xf8\xe1\xae\x48\x96\xe7\xed\xf3\x52\x6d\xb9\xaf\xb6\xf8\x56\x9b\xce\xd5\x0f\xdb\x59\xfd\x94\x9c\x0b\x49\x5a\xd2\xf1\xa6\xc8\x1d\x93\xf8\x76\x60\x19\x51\x1b\xb0\xaa\xb1\xa9\xeb\xb2\x74\xb1\x2c\x9b\xb2\x7c\x41\x2c\xcb\xba\x30\x94\x7a\x71\x71\x0d\xd6\xe9\x6d\x00\x9c\x94\x47\x54\xd7\xc3\x67\x67\x88\xe1\xbd\xeb\x04\xe5\x0a\x0f\x18\xf0\x4c\xb2\x6c\xc2\x03\x5f\x1c\xa5\x53\xf1\x3d\x00\x00\xff\xff\xf9\x93\x93\xde\x56\x05\x00\x00"
+var _pluginsCouchpotatoDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x54\x4d\x6f\xdb\x30\x0c\xbd\xe7\x57\x10\xf6\x75\x9e\xb1\xab\x6f\x43\x76\x29\xb0\x02\x45\xb3\xee\x6a\x28\x16\x1d\x09\x93\x25\x8d\xa2\xe3\x65\xbf\x7e\xb4\xdd\xc4\x4e\x91\x6e\x3d\x74\x27\x51\xa4\xc8\xf7\xc4\x2f\xfc\x15\x43\x42\x5d\x77\xc8\x26\xe8\x54\x6d\x0a\xb8\xf3\x89\x95\x73\x22\x3d\xa2\x48\xc4\x22\x3d\xc5\x03\x29\x8d\x93\xa4\x15\xa3\x58\x42\x4f\x0d\x26\xd1\x6c\x8d\xf2\x07\x7c\x50\x29\x0d\x81\xb4\x28\x76\x1c\xe2\x74\x8c\xbe\x06\x95\x63\x33\xc6\xe5\x53\xc4\x0a\x0c\x73\xdc\x00\x84\xc8\x36\xf8\x0a\x06\xdc\xd7\x31\xc8\xbb\x99\x40\x3d\xeb\x85\x07\x9c\x79\x8c\x62\x01\x1a\x5b\xd5\x3b\xae\x8f\xca\xf5\x12\x65\x7f\x62\x4c\xf6\x37\x6a\x31\x02\x18\xeb\xb9\x82\xec\x9b\x41\xe8\x13\x92\x57\x1d\x02\x07\x70\xe1\x60\xfd\x28\xb0\x18\x04\xa8\x90\x67\x48\xad\x6a\x30\x9b\xdc\xc6\x77\xd5\xc5\x63\x52\xcd\x1c\x13\x93\xf5\x87\x1b\xb8\x2b\xb7\x78\xfe\xef\xca\x0d\x1b\x42\x5e\x33\xba\x6b\xe1\x14\x7a\x70\xa8\x8e\xc2\xc8\xd8\x04\xd8\x45\x3e\x81\x02\x52\x5e\x87\xee\x12\x05\x06\xeb\x1c\xec\x51\x62\x38\x6c\x18\x35\xb4\x81\x46\xdf\xec\xd6\xef\x4b\x13\x3a\x2c\x2f\x39\x28\x9b\xe0\x5b\x7b\x90\xa3\x6f\x4c\x0c\xac\x38\xac\x88\xce\xc6\xba\x0d\x4e\x23\xad\xd8\x46\xc5\x66\xba\x4a\x8e\xc3\x50\x6b\x01\x9e\x4b\xc2\xd4\xe3\x5b\x50\xa5\x0f\xd4\xea\xb3\x5f\xe4\xba\x7c\x83\x03\xc9\x27\x0c\x12\x7e\x5c\x71\x19\x5d\x5e\x65\xf2\x4f\xc0\x0e\xb5\x55\xe5\x0a\xf2\x49\x3a\x17\x06\x83\x7e\xca\xf2\xa0\x3c\x8f\xd5\x46\xaf\xf6\x0e\x41\xfa\x9a\x8b\x48\x41\x9a\x34\x49\x35\xa7\x84\xde\xce\xd0\x14\xf7\xcd\xb4\x56\xf8\xbb\xa9\x58\x52\xcd\x96\x70\x04\xa4\x09\x9f\x7a\x3f\xd7\x3a\xf8\x0f\xcf\xa5\x9f\xab\x2e\x36\x33\xde\xd4\xfc\x34\xda\xe6\xc7\x52\xe8\x75\x9a\x2e\x43\xb1\x22\x33\x5f\x9f\x27\xf2\xe6\x4c\x64\xd9\xba\xf5\xb2\xeb\x16\x60\x65\x3d\x52\x6d\xf5\xad\x36\xdf\xfd\x97\x98\x21\xbe\x73\xc8\xeb\x45\xf3\xce\xc1\x01\x08\x7f\xf6\x56\xba\xf6\xf5\x09\xb8\x8e\x3e\xae\x1b\x8f\xc3\x65\x86\xd7\x68\x7f\xdf\x0e\x2f\x90\xce\x04\x97\xde\xa4\xd8\xd4\xb3\x76\xbb\x68\x1f\x1f\xb6\x9b\x64\x64\x54\x2f\x3b\xb2\x58\x96\x57\xb1\x40\x16\x4b\xff\x14\x57\x13\x57\xbc\xd8\x05\x47\xa4\x34\x4d\xfc\xa7\xcd\xe8\xd1\x93\x13\x0b\x75\x8a\xe7\x2d\x5d\x95\x65\x9e\xdb\x98\xe7\x55\x9e\x9f\x23\xe6\x79\xb9\xd1\x98\x1a\xb2\xcf\xfb\x3b\xfb\xec\x41\xf5\x1c\xc4\xcb\x36\xf0\xdd\x6a\x0c\xf0\xd5\xee\x49\xd1\x09\xee\x95\x57\x07\xa4\xa9\xc3\xef\xc3\xd1\x62\xca\x36\x7f\x00\x76\x6a\xd7\x5d\x70\x06\x00\x00"
 
 func pluginsCouchpotatoDataManifestYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -163,7 +163,7 @@ func pluginsCouchpotatoDataManifestYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "plugins/couchpotato/data/manifest.yml", size: 1366, mode: os.FileMode(493), modTime: time.Unix(1498116054, 0)}
+	info := bindataFileInfo{name: "plugins/couchpotato/data/manifest.yml", size: 1648, mode: os.FileMode(493), modTime: time.Unix(1792320008, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -248,7 +248,7 @@ func pluginsDelugeDataHostlistConf12() (*asset, error) {
 	return a, nil
 }
 
-var _pluginsDelugeDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x54\x3b\x8f\x9c\x30\x10\xee\xf9\x15\xa3\xa5\x0d\x87\xd2\xd2\x45\x49\x73\x52\x8a\xe8\x36\xa9\x91\x17\x7f\x2c\x56\x8c\xc7\xb2\xc7\xcb\x6d\x7e\x7d\x64\x60\x1f\xa7\x70\x52\x5e\x57\x20\xb0\x3d\xdf\x63\x34\x7c\xc6\xb3\xe7\x08\xdd\x8e\x90\x81\x75\x6c\x8a\x8a\x1e\x5d\x14\x65\x6d\x51\xd1\x13\xa2\xa8\x20\x45\x45\x7b\x61\x3f\xbf\xf2\x72\xa9\x6d\xd9\x8b\x61\x17\x9b\x82\x2e\x90\xfc\x59\x91\x46\xaf\x92\x95\xf6\xa4\x6c\x42\x43\x87\xb3\x20\x9a\x1f\xd0\x05\x11\xd1\x60\x9c\x34\xb4\xfb\x16\x11\x9c\x1a\x41\xc2\xa4\x92\x0c\x70\x62\x3a\x25\x20\x19\xf2\x63\x1c\x75\xd6\xc0\x09\x4d\x46\x86\xdd\x8c\xcc\xe5\x0d\xa5\x15\x38\x6f\xc9\xd9\xa3\xa1\x28\xc1\xb8\xe3\x96\x74\x3d\xf0\x88\xfa\x6a\xa0\xee\xd8\xf5\xe6\x58\x6b\xd8\x74\xc4\xbd\x9d\x45\x41\x59\xcb\x53\xab\x61\x91\x1b\x6b\x48\x42\xc2\x9d\xf4\x82\x6e\x7b\xb6\x1a\xe1\xaf\xf4\xb5\x12\xf5\xab\xec\xc2\x9e\xcf\xfe\x85\x7b\x84\x36\xaf\x92\xcf\x87\x7f\xc0\x7e\xc7\xb3\x87\x45\x27\xa4\xa8\x0f\x00\x79\x0e\x92\x67\x16\x92\xcb\x63\x8a\xc4\xee\x1d\x59\xa8\x13\x08\xa3\x97\x73\x3e\x1b\xf2\x4a\x2d\xa5\xde\x74\xdf\xa1\xa9\xe7\x40\x67\x4e\x0f\x77\x96\x26\x1c\xda\x5c\xf2\x5b\xcd\xae\xcd\x6c\x74\xe6\x55\x8c\x13\x07\xbd\x45\x73\x05\x3c\xf6\x59\x7d\xf5\x39\xdb\x5e\xcc\x2a\x0a\xca\x69\x1e\xaf\x2c\x34\x19\x6b\xe9\x00\x8a\x73\xd7\x37\xe7\x59\x72\x4d\xc3\xe6\x4f\xfe\xba\xc1\x8e\x9d\x28\xe3\x10\x5a\xb3\x69\x72\xff\x26\x9c\xec\xff\x2b\xe5\x52\xf3\x69\xc9\x4d\xf0\x5d\x7b\xbf\xf1\xf4\xe5\x63\x11\x07\x9e\x6e\x17\x42\x75\x8b\x69\x75\x9b\x50\x75\x9b\x79\x45\x5a\x61\x64\x77\x59\xbd\x8c\x56\xf5\x22\x0c\x27\x84\x38\xc7\xf1\x7d\x91\xf1\x29\xd8\xb6\xe7\x30\x2a\x69\x68\x10\xf1\x4d\x5d\x97\xa5\xf1\x65\xd9\x94\xe5\x85\xbf\x2c\xeb\x42\x23\x76\xc1\xf8\x25\xc9\xbb\xaf\x03\xc8\x2a\x41\x94\xd5\x34\xad\xb4\xd4\x07\x1e\xe7\x5b\xe7\x83\xf5\xc6\x81\x3e\x1b\x97\x9e\x29\xc0\x73\x34\xc2\xe1\xfc\xb0\x2b\x7e\x06\x00\x00\xff\xff\x36\x8c\x95\x5a\x24\x05\x00\x00"
+var _pluginsDelugeDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x55\xb1\x8e\xdb\x30\x0c\xdd\xfd\x15\x44\xbc\xd6\x35\xba\x7a\x2b\xae\xcb\x01\x1d\x82\x4b\x6f\x36\x14\x8b\x89\x85\xca\x92\x2a\xd1\xc9\xa5\x5f\x5f\xca\x72\x1c\xe5\x2e\x45\xd3\xe2\x3a\x04\x91\x45\xf1\xf1\x51\x7c\xa4\xf0\xc5\xd9\x80\xb2\x1d\x90\x7a\x2b\x43\x53\x54\xf0\x68\x02\x09\xad\x79\xf5\x84\xbc\xf2\xc4\xab\x67\xb7\xf7\x42\xe2\xb4\x92\x82\x90\x2d\x76\xf4\x1d\x06\xde\x79\xe8\x85\xd9\xe3\x5a\x84\x70\xb4\x5e\xf2\xc6\x86\xac\x9b\xfe\xa2\x6f\x8f\x42\x53\x1f\x71\xe9\xe4\xb0\x81\x9e\xc8\x15\x00\xd6\x91\xb2\xa6\x81\x23\x6e\x5b\x67\xa7\x18\xc9\x4e\x5d\x6e\x96\x02\x07\x6b\xd2\x89\x44\xb1\x4d\x26\x66\x0a\x67\xa6\x71\x59\x81\xc4\x9d\x18\x35\xb5\x07\xa1\x47\xc6\xd9\x9e\x08\x83\xfa\x89\x92\x8d\x00\xbd\x32\xd4\xc0\xea\x39\xa0\x37\x62\x40\x20\x0b\x62\xa4\x1e\x0d\xa9\x8e\xd3\x01\x5e\xf2\x4f\x19\xe8\xb4\xe2\x4d\x38\x2a\xea\x57\x93\x67\x3c\xde\xc0\x38\x3b\x4e\x5b\x89\x68\x20\xaf\xcc\xfe\x56\xe8\xba\xb7\x03\xd6\x0b\x81\xba\xb3\x66\xa7\xf6\xb5\x44\x3d\xee\x31\xa7\x93\x22\x70\x06\xf6\xd8\xb2\x15\x53\xce\xe4\x47\xcc\x42\x27\xef\x76\x67\xb5\x44\x9f\xc5\x77\x82\xfa\x7b\xa2\x73\xb9\xc4\xdb\xa0\x09\x3b\xda\xfe\x1d\x79\x40\xa9\x7e\x0b\x3d\x19\xef\xc6\xce\x50\x36\x7c\x11\x1d\x81\x80\x9d\x47\x84\x58\xf9\x58\x2d\x3f\x9a\x58\xa0\x00\xd6\x7c\x00\x8d\xe2\x80\x80\x83\xa3\x53\xb4\xf5\xf1\x4b\xa4\xa3\x4e\x75\xdf\x51\xc2\xce\x7a\x38\xd9\xf1\x63\x46\x68\x51\x5a\x46\x26\x7d\xbe\x49\x74\x4e\x64\xce\x6a\x3d\x51\x60\x81\x7c\x99\x0a\x38\x6b\x12\xb4\x0a\x84\x26\x12\x9a\xa2\x65\xea\x09\x7f\xc3\xf0\xba\x1c\x17\xb5\xdf\xcd\x32\xb9\xba\x73\xfb\x65\x02\xc5\xce\x23\xe5\x99\x3c\xee\x62\xc8\x99\xdc\x74\x9b\x89\xa1\x00\x2f\x8c\xb4\xc3\x82\xc2\xfa\xd7\x1a\xb6\xc8\x18\xb1\x18\x17\xba\x31\xe4\x3c\x15\x6e\x76\xdd\xf5\xcd\xad\xae\x65\x4c\x42\x19\xf4\xad\x92\xb7\xba\x68\xf3\x5f\x30\xad\x7b\x67\xc8\xeb\x61\xf7\xce\xe0\x00\x1e\x7f\x8c\xca\xa3\x5c\xa6\xc0\x1f\xd0\xbf\xb1\x2c\x0d\x1e\x97\xc2\xdd\x2f\x89\x57\x91\x92\x4b\x52\x78\xe1\x5d\xd7\xe6\x1b\x4f\xeb\x87\x22\xf4\x3c\xa4\x96\xd9\x5b\x5d\x26\x62\x75\x09\x54\xe5\xe3\x3c\x17\x73\xf5\x6a\x8a\x55\x57\x93\xe7\x80\x3e\x4c\x93\xef\x53\x11\xfd\x47\xaf\xd9\xe2\x07\x41\xe9\xb5\x68\xea\xba\x2c\x95\x2b\xcb\xa6\x2c\xcf\xf8\x65\x59\x17\x12\x43\xe7\xd5\xfc\x50\x4c\x37\xa1\x79\x94\x07\x3a\xf7\xe9\x0c\xcb\x73\x84\x95\x1d\xfb\xf7\xb3\x76\x7c\xf9\xf0\x55\x99\xf1\x85\xf3\xe7\x87\x4f\x91\xf5\x27\x6e\xc1\x5f\xac\xc3\x3a\x8b\x06\x07\x00\x00"
 
 func pluginsDelugeDataManifestYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -263,7 +263,7 @@ func pluginsDelugeDataManifestYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "plugins/deluge/data/manifest.yml", size: 1316, mode: os.FileMode(493), modTime: time.Unix(1498116055, 0)}
+	info := bindataFileInfo{name: "plugins/deluge/data/manifest.yml", size: 1798, mode: os.FileMode(493), modTime: time.Unix(1792320008, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -328,7 +328,7 @@ func pluginsFilebotDataFilebotSh() (*asset, error) {
 	return a, nil
 }
 
-var _pluginsFilebotDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x54\x4d\x6f\x9c\x30\x10\xbd\xf3\x2b\x9e\x38\x87\x45\xbd\x72\x6d\x55\xb5\x3d\x55\x49\x55\xa9\x27\xe4\xe0\x01\xac\x35\x1e\xe4\x19\x76\x4b\x7e\x7d\x65\x3e\xd4\x90\x6e\x94\x4b\x73\x02\x86\x67\xbf\xf7\xe6\x8b\x7e\x8f\x2c\x64\xeb\x81\xb4\x67\x2b\x55\x56\xe0\x6b\x10\x35\xde\x67\x05\xee\x49\xd4\x44\xcd\x0a\x3c\x28\x8f\xcb\x23\x7d\xae\xd8\x9a\x47\x75\x1c\xa4\xca\xb0\x1f\x49\xaf\x05\x2c\xb5\x66\xf2\x5a\x5f\x8c\x9f\xa8\x42\xd9\xf3\x40\xe5\xe3\xac\x24\xee\x89\x6c\xd9\x70\x68\x5d\x57\xb6\xce\xd3\x23\x6b\x06\x00\xc1\x0c\x54\x61\xfd\x51\xb7\xec\x2d\xc5\x25\xae\xf3\x48\x15\x44\xa3\x0b\xdd\x12\x30\xde\xf3\xb5\xb6\xe4\x29\x51\x57\xd0\x38\xd1\x2d\xce\xbc\xec\x59\xb4\x1c\xc8\x3a\x93\x3f\xa3\xc8\x79\xd2\x71\xd2\x8d\x23\xbf\x4d\xd2\xbb\xa0\x15\xf2\x1f\x3d\x61\xc5\x41\x88\x02\xda\xc8\x03\x5c\x10\x67\x09\xda\x13\x3e\x71\x73\xa6\x98\x54\xab\x71\x81\x22\xae\x3d\x45\xc2\xcc\x53\xc4\xe7\xd5\x1c\x22\xc9\xe4\x55\x20\x3d\x4f\xde\xa2\x63\xb8\xa0\x7c\xc2\xaf\x04\x4a\x79\xd9\x19\x9c\x60\xe0\x29\x28\x59\x18\xc1\xa2\x1e\xc2\x0b\xcf\x66\x2d\x41\x5e\xb8\x7a\xcd\xb6\x35\x6a\xca\x86\x87\xd1\x93\x92\x3d\xf8\x77\xe1\x9d\xed\xef\xce\x37\xc7\x9e\xf9\x8c\x96\x23\x52\xb9\x05\xca\x18\x23\x37\x24\x72\xba\x6d\x40\xe6\xc1\xbb\x70\x3e\x68\xde\x3a\xa5\x36\x4d\x2a\xfa\xdb\xaa\x57\xdc\x4b\x25\x6a\xce\x94\x34\x86\x5d\x81\x0b\xdd\x5a\xac\x45\xda\x09\x3f\x8d\x77\x16\x7b\x53\x63\x53\x72\x87\x86\xc7\xf9\x0e\x03\x5f\xe8\x84\x2f\x26\xda\x14\x85\x65\x12\x04\x56\x5c\x39\x9e\x5f\xf1\x42\xe1\x60\x43\xa6\x47\x75\xea\xa9\xf6\x26\x74\x6f\xbb\x48\xa8\xc9\x74\x84\x86\x2d\x2d\x29\x4c\x59\xdf\x2f\x91\x7f\xec\xc5\x39\x65\xb7\x75\xc1\xde\xc1\x93\xb9\x10\x68\x18\x75\x5e\x4e\x06\x0e\xb4\x88\xdc\x06\xfa\xe6\x9c\xe6\xf9\x73\x09\xf9\x71\x30\xd7\x1a\xd7\xce\xde\xd2\xfd\xf0\x2e\x77\xf2\xf8\x5f\xaf\x5c\x31\x5b\xd6\xb2\x38\x36\xf5\x21\x72\xff\xfd\x63\x26\x3d\x5f\xff\x6e\xb5\xe2\xc5\x42\x2a\xf0\x7c\x78\xb2\x02\x87\x5d\x92\x15\x38\xf6\x69\x76\xa1\x28\xcb\x92\xfa\x90\x59\x92\x26\xba\x71\xdd\x59\xf9\x5e\x39\x27\x30\xcb\x21\x70\xec\x4c\x70\x4f\x69\x88\xa2\x53\xa5\x00\x17\xf0\xcd\x5c\x4c\x9e\xfd\x09\x00\x00\xff\xff\x65\xef\x0c\xe3\xa0\x05\x00\x00"
+var _pluginsFilebotDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x54\xc1\x6e\xdb\x30\x0c\xbd\xfb\x2b\x08\xef\xda\x24\xd8\xb5\xd7\x0e\xc3\xb6\x53\xd1\x6e\x03\x86\x61\x30\x14\x8b\xb6\x85\xc8\xa2\x20\xd1\xc9\xbc\xaf\x1f\x25\xdb\x68\x9c\xb9\xb7\xf5\x64\x8a\xa6\xf8\xde\x23\x29\xe2\x6f\x4f\x11\x75\xd5\x23\x77\xa4\xe3\x7d\xb1\x83\xcf\x2e\xb2\xb2\x56\xac\x27\x14\x2b\xb0\x58\xdf\x7c\x1b\x94\xc6\x6c\x69\xc5\x28\x7f\x68\x08\x35\x46\xf1\x3c\x33\xf9\xfc\x49\xa1\x53\x9e\x8a\x3c\x1b\x72\x92\x0e\x96\x74\xc9\xdc\x81\xc6\x46\x0d\x96\xab\xb3\xb2\x03\xde\xc3\xa1\xa3\x1e\x0f\xc7\x91\x31\x9a\x3f\xa8\x0f\x35\xb9\xc6\xb4\x87\xc6\x58\x3c\x12\xcb\x0d\x00\xa7\x7a\x09\x9c\x7e\x54\x0d\x59\x8d\x21\xfb\x79\xf4\xe2\xf7\x8a\xbb\x7c\x14\x04\xba\x54\x1a\x2d\x26\xe0\x7b\xe0\x30\xe0\x16\x62\x29\x90\x91\x0f\x3d\x6a\xa3\xca\x2b\x80\x92\x06\xf6\x03\xcf\x08\xe5\x16\x44\x67\x1c\x4b\xe0\xd7\x0e\x61\x8a\x82\x88\xe8\xa0\x09\xd4\x83\x71\xd1\x68\x04\x96\x7f\x1f\xa8\x3e\xc9\x3f\x61\xcc\xca\x38\xb1\x2e\x1d\x06\x84\x51\xea\x05\x1f\x27\x61\x10\x30\x0a\xa7\x08\xb1\xa3\xc1\x6a\x68\x49\x12\x30\xed\xe1\x47\x0a\x4a\x35\x59\x10\x4c\x84\x9e\x06\xc7\xa8\x41\x45\xc8\xdc\x21\x52\xc6\x99\x85\xa5\x90\x1b\x4d\xaf\x89\x96\xc6\x29\x29\x71\xef\xa5\x48\xa8\x57\xea\x8d\x7b\x53\xf1\x8b\xee\x59\xaf\x25\x3a\x49\x9a\x00\xa9\xd1\x11\x98\xc0\x07\x92\x61\x8a\xfb\x6d\xfa\x71\xec\xad\x71\xa7\x15\xe3\x79\x46\x2a\x55\xa7\x86\x5f\x73\x46\x37\xf4\xb7\x9c\xa7\xa8\x5b\x1e\xac\x4e\x98\x18\xba\x05\xdf\xb8\x76\x6a\x54\x26\xb6\x87\xef\xca\x1a\x0d\xcb\x30\xc3\xcc\xe3\x4e\xf4\xf9\xf1\x4e\x3a\x73\xc6\x3d\x7c\x52\x41\x27\x2f\x68\x12\x2d\x4e\xb2\x5f\x28\x9c\xf6\x13\xa3\xac\x40\x6e\xfe\xdc\xb8\xfa\x6b\x53\x2b\xba\x95\xcc\x38\x1c\xd9\xb0\xc5\xca\x2a\xd7\x5e\xab\x8c\x1c\x84\xed\xad\xce\x14\x35\xa8\x16\x05\x46\x63\x2e\x71\xea\xca\x92\x24\xfe\x53\x80\x30\xa6\xea\x37\xc6\xe9\x3b\xb0\xa8\xce\x08\xd8\x7b\x1e\xf3\x4d\x47\x0e\xb3\x8c\x79\x0d\x6c\xbe\xe0\xb2\xbc\xa6\x50\xae\x9f\xec\x34\x03\x95\xd1\x5b\xbc\x9f\xdf\x24\x27\xf9\xff\x9a\x72\x8a\x99\xab\x56\x04\x5f\x57\x2b\xcf\xd3\xe3\x43\x21\xa5\xbc\xbc\xec\xbb\xdd\xcd\xaa\xda\xc1\xf5\xd3\x92\xe3\x6a\xcf\xc8\x79\x3d\xc7\xc5\x3b\x38\xd6\xfa\x65\x94\x4e\x88\x3e\xb7\xd0\xab\x18\x65\xae\x64\x18\x1b\x39\xcb\x9b\x57\xde\xcb\x30\x75\xd2\x6f\x04\x23\x5b\xc0\xe5\x30\xf1\xca\x29\xa2\x6d\xf6\xc5\xe0\xe2\xe0\x3d\x05\x5e\xaf\xf7\x87\x7c\xe7\x71\xce\x57\x9c\x31\xc4\xbc\x31\xdf\x17\x1a\x63\x1d\x8c\x9f\x16\x68\xb9\x8c\x4a\xc2\xca\x2c\x81\x42\xab\x9c\xec\x69\x79\xd5\xc1\x30\xcb\xb3\x11\xd4\x2f\xea\x2c\x4b\xe7\x2f\xe5\x51\xfe\x87\x47\x06\x00\x00"
 
 func pluginsFilebotDataManifestYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -343,7 +343,7 @@ func pluginsFilebotDataManifestYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "plugins/filebot/data/manifest.yml", size: 1440, mode: os.FileMode(493), modTime: time.Unix(1498116055, 0)}
+	info := bindataFileInfo{name: "plugins/filebot/data/manifest.yml", size: 1607, mode: os.FileMode(493), modTime: time.Unix(1792321108, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -368,7 +368,7 @@ func pluginsHeadphonesDataConfigIni() (*asset, error) {
 	return a, nil
 }
 
-var _pluginsHeadphonesDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x54\x4f\x8f\x9c\x3e\x0c\xbd\xf3\x29\xac\xe1\xfa\xe3\x87\x7a\xe5\x56\xb5\x87\xae\xd4\x95\xaa\xdd\xf6\x8c\x0c\x31\x93\xa8\x21\x8e\x1c\x33\x74\xfa\xe9\xab\xc0\xec\x40\x2b\xd4\x3f\x52\x7b\x1a\xc8\xd8\xcf\x2f\xef\xf9\x41\x5f\x22\x27\x32\xed\x48\x6a\xd9\xa4\xa6\xa8\xe0\x21\x24\x45\xef\x8b\x0a\x9e\x28\x29\x8a\x16\x15\x3c\x2b\xc7\xe5\x27\xbf\xae\xb5\x2d\x47\x75\x1c\x52\x53\xc0\x4b\x4b\x7e\xac\xc0\xd0\x80\x93\xd7\xf6\x82\x7e\xa2\x06\xba\xab\x52\x72\x5f\xc9\x14\x00\x00\xd6\x05\x6d\xe0\xf4\xd1\x12\x4c\x89\x24\xe0\x48\xa0\x0c\x9e\xcf\x2e\xe4\x07\xb5\x04\x33\x75\x95\x0b\x4a\x32\x60\x4f\xa7\xa5\x2d\xd7\x35\xf7\x8e\xe5\x48\xaf\x91\x1a\x48\x2a\x2e\x9c\x0f\xe6\xee\xa7\xed\x31\x22\xa6\x34\xb3\x98\x23\x8c\x7b\xc3\xc3\x00\x57\x9e\xc0\x13\x5e\x08\xd4\xba\x04\x34\x46\xbd\x02\x82\x60\x30\x3c\xde\x51\x60\x76\xde\x43\x47\x90\xc8\x53\xaf\x64\x60\x60\xc9\xbd\xa7\x23\x29\x6a\xcb\x23\xd5\x77\x41\xea\x9e\xc3\xe0\xce\xb5\x25\x34\xd1\x72\xa0\xb4\xe3\xb9\xfe\xd7\x0e\xec\x0d\xc9\x31\x59\xf4\x9e\xe7\xd6\x90\xa7\x6c\x44\x03\x2a\x13\xfd\xce\x58\x83\x8a\xbb\xdb\xbe\x45\xc5\xed\x1e\xca\x42\x06\x2c\x09\xfd\xbf\x63\x93\x5b\x7e\xc2\xe5\x97\x23\x47\x32\x0e\xeb\xdd\xd0\x4f\x89\x0c\xcc\x96\xc2\x22\xf4\x8c\x41\xb3\xfb\x14\xb0\xf3\x04\x91\x93\x56\x51\xb8\xa7\x94\x5c\x38\x2f\x9a\x1e\x8a\xb4\xc0\xfe\x01\xaf\x1d\x81\xe7\xc5\x30\x40\x18\x84\xf2\x44\x59\x08\xc8\x14\x56\xbf\x39\xfc\x77\xb3\x7f\x75\x5e\x19\x6c\x7e\xc3\xb5\x34\xba\xfe\xf3\x66\xf6\x5e\xa9\x99\xba\x36\x97\x1c\xd1\xb9\xe5\xe9\x30\x26\xb7\x25\x3d\xd8\xd8\x9e\x83\xa2\x0b\x24\xad\x3b\xdc\xda\xe7\x7f\x82\xc9\xf1\xaf\x42\xae\x35\x3b\x13\x25\xf6\xed\x7a\xf8\xee\x7e\xf8\xf4\xe1\x4d\x91\x2c\xcf\xdb\xa7\xa5\xda\x32\x5f\x6d\xd1\xad\x36\x95\xab\xef\x56\xb3\xfa\x21\x36\x17\x92\xb4\x44\xe3\x55\x91\x3b\x26\xf1\xed\xc0\x32\xa2\x36\x60\x55\x63\x53\xd7\x65\xe9\x62\x59\x36\x65\xf9\x82\x58\x96\x75\x61\x28\xf5\xe2\xe2\x9a\xaa\xd3\xeb\x00\x38\x29\x8f\xa8\xae\x87\xf7\xae\x13\x94\x2b\x3c\x62\xc0\x33\xc9\xb2\x01\x8f\x53\x72\xfd\xa9\xf8\x16\x00\x00\xff\xff\xcb\xf0\xa7\xfe\x4b\x05\x00\x00"
+var _pluginsHeadphonesDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x54\x3d\x6f\xdc\x30\x0c\xdd\xef\x57\x10\xf6\x5a\xd7\xe8\xea\xad\x48\x87\x06\x68\x80\x20\x69\x66\x43\x67\xd1\x27\xa1\xb2\xa4\x52\x74\xdc\xeb\xaf\x2f\x6d\x27\x3e\x5d\x70\x69\x33\xa4\x93\x28\x52\xe4\x7b\xe2\x17\xfe\x8a\x21\xa1\x6e\x07\x64\x13\x74\x6a\x76\x15\x5c\xfb\xc4\xca\x39\x91\xee\x50\x24\x62\x91\x1e\xe2\x81\x94\xc6\x45\xd2\x8a\x51\x2c\x61\xa4\x0e\x93\x68\xae\x8c\xf2\x07\xbc\x55\x29\x4d\x81\xb4\x28\xee\x39\xc4\xe5\x98\x7d\x0d\x2a\xc7\x66\x8e\xcb\xc7\x88\x0d\x18\xe6\xb8\x03\x08\x91\x6d\xf0\x0d\x4c\xb8\x6f\x63\x90\x77\x2b\x81\x76\xd5\x0b\x0f\x78\xe6\x31\x8b\x15\x68\xec\xd5\xe8\xb8\x7d\x54\x6e\x94\x28\xfb\x23\x63\xb2\xbf\x51\x8b\x11\xc0\x58\xcf\x0d\x14\xdf\x0d\xc2\x98\x90\xbc\x1a\x10\x38\x80\x0b\x07\xeb\x67\x81\xc5\x20\x40\x95\x3c\x43\xea\x55\x87\xc5\xe2\x36\xbf\x6b\x36\x8f\x45\xb5\x72\x4c\x4c\xd6\x1f\x2e\xe0\x66\x6e\xf1\xf9\xbf\x99\x1b\x76\x84\x9c\x33\xba\xee\xe1\x18\x46\x70\xa8\x1e\x85\x91\xb1\x09\x70\x88\x7c\x04\x05\xa4\xbc\x0e\xc3\x16\x05\x26\xeb\x1c\xec\x51\x62\x38\xec\x18\x35\xf4\x81\x66\xdf\xe2\xd2\xef\x6b\x13\x06\xac\xb7\x1c\xd4\x5d\xf0\xbd\x3d\xd4\x92\x6a\x1d\x4d\xf0\x52\x95\x13\xcf\xd5\xd6\xf6\xc1\x69\xa4\x8c\x6c\x54\x6c\x96\xab\xa4\x38\x4c\xad\x16\xdc\xb5\x22\x4c\x23\xbe\x05\x54\xda\x40\x65\x7f\xfd\x22\xd7\xd3\x2f\x38\x90\xfc\xc1\x20\xe1\xc7\x8c\xcb\xec\xf2\x2a\x93\x7f\x02\x0e\xa8\xad\xaa\x33\xc8\x07\x69\x5c\x98\x0c\xfa\x25\xc9\x93\xf2\x3c\x17\x1b\xbd\xda\x3b\x04\x69\x6b\xae\x22\x05\xe9\xd1\x24\xc5\x5c\xf2\x79\x31\x41\x4b\xd8\x37\xb3\xca\xe0\xef\x97\x52\x49\x2d\x7b\xc2\x19\x8f\x16\x78\x1a\xfd\x5a\xe9\xe0\x3f\x3c\x15\x7e\xad\xb9\xd8\xcc\x7c\x53\xeb\xd3\x68\xbb\x1f\xa7\x32\xe7\x59\xda\x46\x22\x23\xb3\x5e\x9f\xe6\xf1\xe2\x44\x14\x45\xde\x78\xc5\x79\x07\xb0\xb2\x1e\xa9\xb5\xfa\x52\x93\xdf\xff\x97\x98\x21\xbe\x73\xc8\xf3\x35\xf3\xce\xc1\x01\x08\x7f\x8e\x56\x9a\xf6\xf5\x01\x38\x8f\x3e\x2f\x1b\x8f\xd3\x36\xc1\x39\xda\xdf\x77\xc3\x0b\xa4\xd5\x25\x6b\x4d\x8a\x5d\xbb\x2a\xbf\x6e\xca\xbb\xdb\xab\x5d\x32\x32\xa7\xdb\x7e\xac\x4e\x8b\xab\x3a\x01\x56\xa7\xee\xa9\xce\xc6\xad\x7a\xb1\x08\x1e\x91\xd2\x32\xee\x9f\x76\xb3\xc7\x48\x4e\x2c\x34\x28\x5e\x37\x74\x53\xd7\x65\x69\x63\x59\x36\x65\xf9\x1c\xb1\x2c\xeb\x9d\xc6\xd4\x91\x7d\xda\xdd\xc5\x67\x0f\x6a\xe4\x20\x5e\xb6\x83\x6f\x76\x4f\x8a\x8e\x70\xa3\xbc\x3a\x20\x2d\x9d\x7d\x33\x26\xdb\x15\xbb\x3f\xf1\x84\x15\x6b\x65\x06\x00\x00"
 
 func pluginsHeadphonesDataManifestYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -383,7 +383,7 @@ func pluginsHeadphonesDataManifestYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "plugins/headphones/data/manifest.yml", size: 1355, mode: os.FileMode(493), modTime: time.Unix(1498116055, 0)}
+	info := bindataFileInfo{name: "plugins/headphones/data/manifest.yml", size: 1637, mode: os.FileMode(493), modTime: time.Unix(1792320008, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -408,7 +408,7 @@ func pluginsJackettDataServerconfigJson() (*asset, error) {
 	return a, nil
 }
 
-var _pluginsJackettDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x92\x31\x8f\xdb\x30\x0c\x85\x77\xff\x0a\xc2\x5e\xeb\x1a\x5d\xb5\x15\x9d\xd2\x29\xb8\xdc\x2e\x30\x16\x7d\x56\x23\x8b\x02\x45\xc7\xcd\xfd\xfa\xc2\x56\xd2\x1c\x0a\xa3\x53\x3b\xd9\xa2\x3f\xbe\x67\x91\x8f\x7e\x26\xce\xe4\xec\x44\x3a\xb2\xcb\xa6\x6a\xe1\x10\xb3\x62\x08\x55\x0b\x2f\x94\x15\x45\xab\x16\x4e\xca\x69\x7b\xac\xc7\xc2\x5a\x4e\xea\x39\x66\x53\xc1\xa3\x65\x7d\x6d\xc1\xd1\x80\x73\x50\x7b\xc5\x30\x93\x81\x6e\xe4\x89\xba\xf3\x4d\x29\xfb\x77\x72\x5d\xcf\x71\xf0\x6f\xdd\x0f\xec\x2f\xa4\x5a\x01\x00\x44\x9c\xc8\x40\xf9\x60\x07\x0e\x8e\x64\xab\xeb\x2d\x91\x81\xac\xe2\xe3\xdb\x56\xc0\x10\x78\xb1\x8e\x02\xad\xd6\x06\x54\x66\xda\xf1\xdc\xd8\xd1\x47\x35\x50\xd7\x1f\x1c\x12\xe6\xbc\xb0\xb8\x7d\xf1\x7b\xc3\x61\x80\x1b\xcf\x10\x08\xaf\x04\x3a\xfa\x0c\x34\x25\xbd\x01\x82\x60\x74\x3c\xfd\x56\x81\xc5\x87\x00\x67\x82\x4c\x81\x7a\x25\x07\x03\xcb\xda\x5b\xff\xfd\x97\x4e\x1b\x0e\x08\x83\x10\x41\x62\x51\x50\x06\x99\x63\x71\xe3\xf8\xe9\x6e\x5e\x7c\x95\x61\x5c\x4f\x58\xd0\xe4\xfb\xcb\xd3\xea\xf3\x87\xdb\x2d\x74\xb6\x2b\xb2\x77\xbb\xfb\x26\x77\x17\x74\x1f\xd1\xce\xbc\x7a\x8e\x8a\x3e\x92\x58\xbf\x3b\xb3\xd3\x7f\xd1\xe4\xf4\x4f\x25\x0b\xf3\x88\x9b\xa4\xde\x96\xca\xf7\x52\x79\x39\x7e\xab\xf2\xc8\xcb\x33\xce\xed\x33\x27\xed\x73\xa8\xed\x1f\xf9\xbc\x92\xe4\x2d\x83\x5f\xaa\x95\x99\x25\xd8\x81\x65\x42\x35\x30\xaa\x26\xd3\x75\x4d\xe3\x53\xd3\x98\xa6\x79\x68\x34\x4d\x57\x39\xca\xbd\xf8\x54\xe2\x5b\xbf\xb2\xbc\x47\x3c\x03\x46\x07\xaf\x2c\x42\x51\x8f\xac\xa8\x0c\x5f\x8f\x87\xbc\x2d\xf9\xc4\x11\x45\x36\x42\x47\x82\xe0\x2f\x94\xeb\xea\x57\x00\x00\x00\xff\xff\xb3\x97\x71\x8c\xb7\x03\x00\x00"
+var _pluginsJackettDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x54\x3d\x8f\xdb\x30\x0c\xdd\xfd\x2b\x08\x7b\x6d\x6a\x74\xf5\x56\xdc\x94\x4e\xc1\xe5\x3a\x1b\x8a\x45\x47\xea\xc9\x92\x4a\xd1\x71\x73\xbf\xbe\xb4\x95\x6f\xdc\x01\x1d\xae\x93\x29\x7e\xbc\x47\x51\x8f\xc6\x3f\x31\x24\xd4\xed\x80\x6c\x82\x4e\x4d\xb1\x82\xb5\x4f\xac\x9c\x13\xeb\x19\xc5\x22\x16\xeb\x67\xdc\x93\xd2\xb8\x58\x5a\x31\x4a\x24\x8c\xd4\x61\x12\xcf\x93\x51\x7e\x8f\x1b\x95\xd2\x14\x48\x8b\x63\xcb\x21\x2e\x9f\xb9\xd6\xa0\x72\x6c\x66\x5c\x3e\x46\x6c\xc0\x30\xc7\x02\x20\x44\xb6\xc1\x37\x30\xe1\xae\x8d\x41\xf2\x72\x03\x6d\xf6\x4b\x1f\x70\xee\x63\x36\x57\xa0\xb1\x57\xa3\xe3\xf6\xa0\xdc\x28\x28\xb5\x09\x03\xd6\xbb\x23\x63\xb2\x6f\xa8\xeb\x2e\xf8\xde\xee\xeb\x5f\xaa\x7b\x45\x66\xa9\x00\xf0\x6a\x90\xc4\x1c\x68\xfb\xe0\x34\xd2\xe2\xcf\x6d\x44\xc5\x66\x39\x0a\x43\x98\x5a\x8d\x0e\x73\x43\x4c\x23\xbe\xc3\x78\x03\x19\xcf\x37\xbd\xa2\x25\xec\x08\x33\xad\xb1\x9e\x1b\x28\xd7\x3d\x1c\xc3\x08\x0e\xd5\x01\x81\x8d\x4d\x80\x43\xe4\x23\x28\x20\xe5\x75\x18\x2e\x28\x30\x59\xe7\x60\x87\x82\xe1\xb0\x63\xd4\xd0\x07\x9a\x6b\xcb\x8f\xba\xc8\x0c\xdb\x25\x5d\xf0\x7a\x42\x84\x79\x84\xc0\x01\x68\xf4\x99\x2d\xf8\x2f\x27\xf2\xcc\x2b\x31\x33\x9f\x54\x4e\x8d\x56\x06\x75\xa1\xfa\x7a\x73\xbb\xcb\x83\xdc\xcc\x2a\x1f\x4f\x6a\x78\xf7\x3d\xca\xf2\xf6\xf2\xe5\xfd\x03\xb0\xb2\x1e\xa9\xb5\x77\x13\x63\xb2\x7e\x2f\x8e\xed\x7f\xc1\x0c\xf1\x93\x21\xef\x45\xfe\xc9\xe0\x00\x84\xbf\x47\x4b\xa8\x3f\xd4\xdf\x03\xfa\x8b\x41\xf0\x38\x5d\x54\x54\xfe\xb3\x3e\x1f\x98\x72\xc9\x79\x6f\x28\x76\x6d\xf6\xfc\xc8\x9e\xe7\xcd\x53\x91\x8c\x2c\xc8\x65\x2f\x57\x57\xfc\xd5\x55\x2c\xab\x87\x45\x3b\x20\xa5\x65\x9d\xbe\x15\x73\xce\x48\x4e\x22\x34\x28\xce\x3f\x80\xa6\xae\xab\xca\xc6\xaa\x6a\xaa\xea\x8c\x51\x55\x75\xa1\x31\x75\x64\x4f\xbf\x86\xf2\x25\xd0\x9b\x57\x3b\x90\x85\x01\xb1\x09\x3d\x6f\x02\x2b\x91\xf2\xf7\xcd\x3a\x2d\xe2\xdd\x06\xaf\x88\x96\x0c\x96\x91\x38\xfb\x8a\xa9\x2c\xfe\x02\xd4\x66\xd9\x0c\xd5\x04\x00\x00"
 
 func pluginsJackettDataManifestYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -423,12 +423,12 @@ func pluginsJackettDataManifestYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "plugins/jackett/data/manifest.yml", size: 951, mode: os.FileMode(493), modTime: time.Unix(1498116055, 0)}
+	info := bindataFileInfo{name: "plugins/jackett/data/manifest.yml", size: 1237, mode: os.FileMode(493), modTime: time.Unix(1792320008, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _pluginsMurmurDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x53\xb1\x8e\x9c\x30\x10\xed\xf9\x8a\x27\xea\x90\x55\x5a\xba\xe8\xaa\x2b\x4e\x8a\x6e\xa5\xb4\xc8\x8b\x87\xc3\x8a\xf1\x58\x33\xe3\x25\x9b\xaf\x8f\x80\xcd\xb1\x8a\x48\xaa\xa4\xc2\x33\x7a\xf3\x9e\xe7\xf9\x41\xdf\x33\x2b\xf9\x6e\x22\x1b\xd9\x6b\x5b\x35\x78\x4e\x6a\x2e\xc6\xaa\xc1\x2b\xa9\x39\xb1\xaa\xc1\xd9\x38\xaf\x9f\xa5\xdc\xb0\x1d\x67\x0b\x9c\xb4\xad\xf0\x6b\x64\x39\x36\xf0\x34\xb8\x12\xad\xbb\xba\x58\xa8\xc5\xe5\x66\xa4\xe1\x07\xf9\x0a\x00\xc6\x90\xac\x45\x5d\xaf\x45\x72\x13\xb5\x28\x4a\xb2\x9c\xd6\x96\xdd\x32\xb5\x50\x93\x90\xde\x8e\xd8\x4e\x23\x4f\x74\x7a\xe7\x3c\xf5\x9c\x86\xf0\x76\x9a\x8a\x4c\x45\x1e\x48\xb7\x7e\x37\x70\xf4\x24\x47\xcc\x80\x8b\x91\xe7\xce\x53\xa4\x65\x8f\x16\x26\x85\x0e\x24\xff\x74\xed\xec\x54\x67\x16\x7f\x4c\x7e\x1f\x78\x1e\x70\xe3\x82\x48\xee\x4a\xb0\x31\x28\x68\xca\x76\x83\x83\xb8\xe4\x79\x7a\x67\xc1\x1c\x62\xc4\x85\xa0\x14\xa9\x37\xf2\x18\x58\x96\xd9\xfa\xef\x57\x3a\xaf\x70\x38\x0c\x42\x84\xcc\x62\x30\x86\x94\xb4\xa9\x71\xfa\x70\x17\xdf\x74\x8d\x31\x2e\x95\xdb\xa0\x39\xf4\xdf\x76\xa9\x8f\x0f\xdb\xcd\x74\xe9\x16\xc8\xd1\x76\xf7\x58\x1c\xbe\xf6\xdd\xa2\x03\xbf\x7a\x4e\xe6\x42\x22\xe9\xc2\xa1\x67\xe7\xff\xc2\xc9\xf9\x9f\x52\x6e\x98\x97\x2d\x6d\x92\xfb\xee\xb1\xf1\xfa\xe5\xa9\xd2\x91\xe7\xfd\xcf\x68\xf6\x70\x37\x7b\x60\x9a\xdd\xdd\xe6\xb7\xa0\x5e\x49\x74\x0d\xe3\xa7\xca\x93\xf6\x12\xf2\x96\xcd\xfa\x33\x94\xe4\x4a\xb2\xbe\xd5\x4b\x99\x2e\x91\xf0\x95\x43\x4f\x78\x1a\x9d\xd5\xd5\xcf\x00\x00\x00\xff\xff\x20\x8b\xac\x7e\xc8\x03\x00\x00"
+var _pluginsMurmurDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x54\x4d\x6f\xdb\x30\x0c\xbd\xfb\x57\x10\x3e\xcf\x0b\x76\xcd\x6d\xe8\xa9\x87\x02\x45\xd3\xed\x6a\x28\x16\x1d\x09\x93\x25\x8d\xa2\xe2\x65\xbf\x7e\xb4\x95\x3a\x5e\xe0\x02\x3b\x74\x40\x80\xf0\x4b\xef\x51\x22\x9f\xf1\x57\x0c\x09\x75\x3b\x20\x9b\xa0\xd3\xbe\x6a\xe0\xd1\x27\x56\xce\x89\xf5\x82\x62\x11\x8b\xf5\x2d\x9e\x48\x69\x9c\x2d\xad\x18\x25\x13\x32\x75\x98\x24\xf2\x60\x94\x3f\xe1\xb3\x4a\x69\x0c\xa4\x25\x70\xe0\x10\xe7\xbf\xe9\xac\x41\xe5\xd8\x4c\xb8\x7c\x89\xb8\x07\xee\x62\x05\x10\x22\xdb\xe0\xf7\x30\xe2\xb1\x8d\x41\xca\x0a\x7f\x5b\xe2\xd2\x06\xbc\xb5\x31\x99\x0d\x68\xec\x55\x76\xdc\x9e\x95\xcb\x02\x72\xbc\x30\x26\xfb\x1b\xb5\x24\x01\x8c\xf5\xbc\x87\xba\x9e\x1d\xaf\x06\x29\xc8\x09\x69\xb2\xe6\x50\x21\x4e\x4c\xd6\x9f\xb6\xd0\x76\x26\x0c\xb8\x5b\x30\x77\x5d\xf0\xbd\x3d\xed\x86\x4c\xf2\x5b\x81\x96\x78\xdb\x07\xa7\x91\x56\xc8\x51\xb1\x99\x5d\x69\x37\x8c\xad\x46\x87\xe5\x76\x4c\x19\x37\x08\x57\x90\xf1\xed\xd5\x56\x7d\x62\x47\xc8\xeb\x8b\x3d\xf6\x70\x09\x19\x1c\xaa\x33\x02\x1b\x9b\x00\x87\xc8\x17\x50\x40\xca\xeb\x30\x2c\x28\x30\x5a\xe7\xe0\x88\x82\xe1\xb0\x63\xd4\xd0\x07\x9a\xce\xd6\xef\x75\x51\x18\x0e\x73\xb9\xe0\xf5\x84\x08\xd3\x3c\x80\x03\x50\xf6\x85\x2d\xf8\x4f\x57\xf2\xc2\x2b\x39\x33\x79\xaa\x94\x46\xdb\xfd\xb8\x51\x7d\x5e\xdd\x6e\x99\xee\xea\xad\x8a\x7b\xdd\xac\xcd\xe1\x5e\x07\xb9\x31\x55\x19\x00\x2b\xeb\x91\x5a\xab\xb7\x26\x7b\xf8\x2f\x98\x21\x7e\x30\xe4\xdf\x82\xf9\x60\x70\x00\xc2\x9f\xd9\x12\xea\x77\xf7\xef\x0e\xfd\xd5\x20\x78\x1c\x97\x2d\xaa\xff\x79\x3f\xef\x98\xca\x91\xa7\x22\x1b\x8a\x5d\xbb\x0e\xbc\x3c\x3f\x54\xc9\x88\x3c\x16\x89\x37\x37\x95\x36\x37\xa2\xe6\xb6\x35\xcd\x9d\xe2\xce\x48\x69\xd6\xd5\x97\x4a\x63\xea\xc8\x5e\x3f\x22\xf5\x57\x69\x8a\x24\x3b\xef\xe0\x53\x1e\x8e\x0e\xe1\x7b\xb0\x1d\x4e\x4f\xcd\x75\xf5\x07\xb5\x23\xa3\xfc\xe5\x04\x00\x00"
 
 func pluginsMurmurDataManifestYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -443,7 +443,7 @@ func pluginsMurmurDataManifestYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "plugins/murmur/data/manifest.yml", size: 968, mode: os.FileMode(493), modTime: time.Unix(1498116056, 0)}
+	info := bindataFileInfo{name: "plugins/murmur/data/manifest.yml", size: 1253, mode: os.FileMode(493), modTime: time.Unix(1792320008, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -468,7 +468,7 @@ func pluginsMurmurDataMurmurIni() (*asset, error) {
 	return a, nil
 }
 
-var _pluginsNzbgetDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x53\xb1\x6e\xdc\x30\x0c\xdd\xfd\x15\x0f\xe7\xb5\xae\xd1\x55\x6b\xa7\x2c\x45\x91\x7c\x80\xc1\xb3\xe8\xb3\x50\x59\x14\x24\xfa\xdc\xcb\xd7\x17\xb2\x2f\xb9\x4b\xe0\x06\x1d\x9a\x49\x22\x41\xbe\x47\x3e\xf0\xf1\xef\x28\x99\x6d\x37\xb1\x8e\x62\xb3\xa9\x1a\x3c\x84\xac\xe4\x7d\xd5\xe0\x91\xb3\x52\xd2\xaa\xc1\x93\x4a\x5c\x9f\x12\x6e\xb5\x9d\x44\x75\x12\xb2\xa9\xf0\xd2\x52\xbe\x0d\x2c\x0f\x34\x7b\xed\xce\xe4\x67\x36\x38\x5e\x94\xb3\x7b\x66\x5b\x01\xc0\xe8\x82\x1a\x1c\x0e\x6b\x10\x68\x62\x83\x39\x73\x2a\xbf\x35\xa5\x97\xc8\x06\x59\x93\x0b\xa7\x3d\xb4\x76\x94\x89\xdb\x57\xcc\xb6\x97\x30\xb8\x53\x1b\x9e\x8f\x27\xd6\x3b\xd0\x2d\xdf\x0d\xe2\x2d\xa7\x3d\x64\x80\xbc\x97\xa5\xb3\xec\xb9\xec\x61\xa0\x69\xe6\x7f\xa1\xb4\xa4\xd4\xde\x51\x95\xf8\x53\x88\x26\xb6\xee\x0d\xd3\x9a\xf8\x80\xea\x3d\xe2\xdf\x14\x8f\x94\xf3\x22\xc9\xee\x8f\x7b\x6d\x78\x18\x70\x91\x19\x9e\xe9\xcc\xd0\xd1\x65\xf0\x14\xf5\x02\x42\xa2\x60\x65\x7a\x45\xc1\xe2\xbc\xc7\x91\x91\xd9\x73\xaf\x6c\x31\x48\x2a\xbd\x87\x8f\x47\x7a\x5a\xcb\x41\x18\x12\x33\xa2\x24\x85\x0a\xd2\x1c\x36\x36\x09\x5f\xae\xe4\x1b\xaf\x0a\xc6\x12\xd1\x56\x1a\x5d\xff\xeb\x46\xf5\xf5\x6e\xbb\x85\x8f\x5d\x29\xd9\xdb\xee\x7a\xd1\xbb\x87\x7a\x95\x68\x47\xaf\x5e\x82\x92\x0b\x9c\x3a\xb7\xab\xd9\xd3\xa7\x60\x4a\xfc\xaf\x90\x5b\xcd\x8f\xcd\x28\x29\xf6\xdd\x7d\xe2\xf1\xe7\xf7\x2a\x8f\xb2\xdc\x4c\xdd\xdc\x7c\xd9\xdc\x0e\xa6\xb9\xa9\xdb\xbc\xf3\x58\xf3\xc6\x08\x67\x4e\x79\x3d\xf6\x6f\x55\xe9\x98\x93\xef\x06\x49\x13\xa9\xc1\xa8\x1a\x4d\xdb\xd6\xb5\x8b\x75\x6d\xea\xfa\x05\xb1\xae\xdb\xca\x72\xee\x93\x8b\x9b\x4f\x0e\xdb\x6c\x70\x19\x84\x89\x02\x9d\xd8\x96\xa9\x02\x2b\xac\x2c\xc1\x0b\x59\x4e\x87\xea\x4f\x00\x00\x00\xff\xff\xdf\xdc\x5c\x12\xc4\x04\x00\x00"
+var _pluginsNzbgetDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x54\x3d\x73\xa3\x30\x10\xed\xf9\x15\x3b\xd0\x1e\xc7\x5c\x4b\x9b\x2a\xcd\x4d\x26\xbe\xab\x19\x19\x2d\x48\x73\x42\xd2\x49\x8b\x89\xf3\xeb\xb3\x20\x6c\x63\x8f\x3d\x93\x22\xa9\xd8\x0f\xed\x7b\x2b\xf1\x76\xf1\xcd\xbb\x88\xb2\x19\x90\x94\x93\xb1\xce\x4a\x78\xb6\x91\x84\x31\x6c\xbd\x22\x5b\x81\xd8\xfa\xeb\xfb\x20\x24\x2e\x96\x14\x84\x9c\x71\x63\x68\x31\x72\xe4\x49\x09\xdb\xe3\x8b\x88\x71\x72\x41\x72\x60\x47\xce\x2f\x9f\xb9\x56\xa1\x30\xa4\x66\x5c\x3a\x7a\xac\x41\x11\xf9\x0c\xc0\x79\xd2\xce\xd6\x30\xe1\xbe\xf1\x8e\xcf\xa5\x06\x9a\x14\xe7\x3e\xe0\xd4\xc7\x6c\x96\x20\xb1\x13\xa3\xa1\xe6\x20\xcc\xc8\x28\xfb\x23\x61\xd4\xef\x28\x39\x09\xa0\xb4\xa5\x1a\xf2\x7c\x71\xac\x18\xf8\xc0\x18\x31\xcc\xd6\x12\x4a\xcc\x91\x82\xb6\xfd\x3d\xb4\x4a\xb9\x01\xab\x33\x66\xd5\x3a\xdb\xe9\xbe\xb2\xef\xfb\x1e\x69\x03\x9a\xe2\x4d\xe7\x8c\xc4\xb0\x41\xf6\x82\xd4\xe2\x72\xbb\x6e\x6a\x24\x1a\x4c\xb7\xa3\x30\xe2\x67\x08\xf9\x49\x45\xb5\x21\x9a\xfd\x6f\xa0\x19\x50\xea\x2b\x9e\x25\xf0\x90\xe8\x16\x6f\x53\xe8\x4f\x7f\x7b\xf3\xbc\xd8\x86\xf5\xb5\xd6\xff\xf1\xdc\xc1\xd1\x8d\x60\x50\x1c\x10\x48\xe9\x08\x38\x78\x3a\x82\x80\x20\xac\x74\xc3\x19\x05\x26\x6d\x0c\xec\x91\x31\x0c\xb6\x84\x12\x3a\x17\xe6\xda\xfc\x51\x17\x89\x61\xb7\x1c\x67\xbc\x2e\x20\xc2\x2c\x23\x20\x07\x61\xb4\x89\xcd\xd9\x1f\x2b\x79\xe2\xe5\x9c\x9a\x3d\x91\x8e\x7a\xdd\xfe\xbb\x50\xfd\xdc\xdc\xee\x2c\xca\xcd\x93\x24\x77\x9d\x88\xbb\x9a\x5c\xf5\x77\x47\x8c\xac\x1b\x12\xda\x62\x68\xb4\xbc\x27\xc8\xdd\xb7\x60\x3a\xff\xc5\x90\xd7\x83\xfe\xc5\xe0\x00\x01\xff\x8f\x3a\xa0\x7c\xac\xe7\x6b\xf4\x3f\x0a\xc1\xe2\x74\x56\x51\xfe\x69\x7d\xde\x30\xa5\x92\xdf\x69\xda\x83\x6f\x9b\x6d\xe0\xf5\xe5\x29\x8b\x8a\xc7\xed\xbc\x99\xca\xcb\x72\x29\x2f\x44\xe5\x45\x35\xe5\xcd\xa2\x28\xaf\xe6\xf9\x80\x21\x2e\x53\xfb\x2b\x9b\x2b\xc6\x60\x38\x13\x06\x41\x69\x37\xd6\x55\x55\x14\xda\x17\x45\x5d\x14\x27\xc4\xa2\xa8\x32\x89\xb1\x0d\x7a\xdd\x9a\x79\xea\x0d\x58\xe4\x02\x06\x61\x45\xcf\x42\xe6\xae\x2c\xc7\xa4\x9b\xac\x71\xbc\xac\x43\x9e\x7d\x00\xe8\x9a\x8e\x19\xde\x05\x00\x00"
 
 func pluginsNzbgetDataManifestYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -483,7 +483,7 @@ func pluginsNzbgetDataManifestYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "plugins/nzbget/data/manifest.yml", size: 1220, mode: os.FileMode(493), modTime: time.Unix(1498116056, 0)}
+	info := bindataFileInfo{name: "plugins/nzbget/data/manifest.yml", size: 1502, mode: os.FileMode(493), modTime: time.Unix(1792320008, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -508,7 +508,7 @@ func pluginsNzbgetDataNzbgetConf() (*asset, error) {
 	return a, nil
 }
 
-var _pluginsPlexDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x93\x4f\x6f\xdc\x20\x10\xc5\xef\x7c\x8a\x91\xf7\xec\x90\xfe\x39\x71\x4d\x2f\x95\x7a\x88\x92\xde\xd1\xd8\x8c\x17\x14\xcc\x20\x98\x5d\x67\xfb\xe9\x2b\x6c\x45\x71\xd2\x54\xea\xdf\xd3\x2e\xc3\xe3\x37\x0f\xcf\x83\x1e\x33\x57\x72\x76\x26\xf1\xec\xaa\x51\x3d\x7c\x4e\x55\x30\x46\xd5\xc3\x1d\x55\xc1\x22\xaa\x87\x7b\xe1\xbc\xfe\xb4\xe5\xa6\xb5\x9c\x25\x70\xaa\x46\xc1\xd3\x91\xf6\xb7\x07\x47\x13\x9e\xa2\xd8\x33\xc6\x13\x19\xe8\x3a\x05\x00\xe0\x43\x12\x03\xdd\x4d\xc4\x30\x83\xf0\x03\x25\x18\x31\xc1\x40\xc0\x83\x60\x48\xe4\xc0\x53\x21\x03\x5e\x24\x57\xa3\xf5\xb2\x2c\x57\x39\xd2\xe3\x95\x9c\xf5\xd8\x4e\xe9\x0d\x94\x70\x26\x03\x6d\xc7\xae\xe5\xb5\x28\x97\x4c\x06\xaa\x94\x90\x8e\x6f\x99\xd0\x9e\x67\xd2\xc3\x45\xa8\x86\x6f\xe4\xf4\xc8\x69\x0a\x47\xdd\x28\x7b\x77\xfb\x0e\x9b\xc4\x4e\x1c\x1d\x95\x3f\x6a\x32\x93\x0b\xb8\xc7\x7f\xf5\x04\x1b\x0f\xc4\xa3\xb4\x16\xed\xea\x15\x2e\x7c\x2a\xb0\xca\xf7\x0e\xd6\xc2\xdf\x18\x70\x28\xf8\xb3\xeb\xb5\xbd\xdf\x61\xbf\x1c\xe3\x0f\xa3\xc8\x58\xeb\x8e\x33\x30\x47\xc2\xa4\xe0\x29\x43\xbf\x10\x8d\x57\x1f\x7f\x0d\x45\xb1\xc1\xbd\x65\xef\xfe\xbf\x30\x39\xff\x53\xe4\xa6\xb9\x6d\x19\x2b\x79\xb4\xcf\xcb\xbb\xdb\x1b\x55\x3d\x2f\xcf\x4f\xa8\x7f\x15\xb7\xfe\xc5\x7c\xce\x54\x6a\xe0\x64\xe0\x9d\x5a\x68\xb0\xa7\x12\xed\xc4\x65\x46\xd9\x5e\x8b\xd1\xfa\x70\x08\xf9\x70\x30\x1f\xde\x7f\xbc\xbe\x56\x8e\xea\x58\xc2\x8a\x36\xd0\x7d\x41\xa1\x2a\x6b\x5f\xa8\x54\xce\x54\x60\xc0\x4a\x0e\x38\x81\x78\x02\x9e\xa6\x30\x06\x8c\x9b\xe2\x13\x8f\x0f\x54\x20\xcc\x78\xa4\x4e\x7d\x0f\x00\x00\xff\xff\xd5\x33\x3d\x4d\x1e\x04\x00\x00"
+var _pluginsPlexDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x54\xc1\x6e\xdb\x30\x0c\xbd\xfb\x2b\x88\xe4\xba\xc4\x49\x96\x5d\x72\xcd\x2e\x03\x7a\x08\xda\xee\x6c\xc8\x16\x1d\x0b\x91\x45\x41\xa2\xe3\x66\x5f\x3f\xca\x6e\x50\xa7\xeb\x80\xa0\xd8\x4e\x26\xa9\xe7\xf7\x48\x91\x14\xbe\x78\x8a\xa8\x8b\x16\xb9\x21\x1d\x77\xd9\x02\x7e\xb8\xc8\xca\x5a\xb1\x1e\x51\xac\xc0\x62\xfd\xf4\xc7\xa0\x34\x0e\x96\x56\x8c\x72\x42\x5d\xa8\x30\x4a\xe4\x89\xc9\x0f\x9f\x04\x1d\x79\x0a\xf2\x6c\xc8\x09\x1d\x5c\xe9\x92\xb9\x00\x8d\xb5\xea\x2c\x17\x67\x65\x3b\xdc\xc1\x6c\x26\x51\x80\xc6\x38\x16\x67\x6f\x95\x69\x81\xe9\x84\x0e\x2a\xe5\xa0\x44\xa0\x92\x95\x71\xa8\xa1\xc1\x20\xf8\x86\xd9\xc7\x5d\x9e\xf7\x7d\xbf\xf4\x16\x5f\x96\x7c\xce\xab\xf4\x57\x3e\x12\x39\xd5\x0a\x2a\x9d\x14\x43\x78\x08\xf2\xc5\x4b\x30\x72\x30\xee\xf8\x51\x12\x79\x43\x2d\xe6\xe5\x85\x31\x9a\x5f\xa8\xf3\x8a\x5c\x6d\x8e\x79\x62\x99\x66\x37\x55\x18\x21\x45\x4d\x56\x63\x98\x88\x78\xc5\xcd\x3d\x12\x2d\x6a\xa3\xa6\xe4\xcf\x0d\xc2\xc8\x06\xdc\x28\x4e\x02\xa9\xf0\x08\x17\xb9\x66\x18\xe0\x53\xfd\x21\xf0\x79\x79\xe9\xa0\xfa\x5b\x69\xe9\xec\x7e\xe6\xdb\x06\xfe\xd1\x04\xaf\x62\x9c\xb0\x94\x44\x56\xdc\xd7\xb1\xba\x63\x22\xde\xdd\xf9\x30\x0b\xa1\x30\xfa\xa3\xbe\x3e\xfd\x17\x4e\xf2\xff\x94\x72\xc4\x1c\xd2\x68\x85\xeb\x0a\x25\x81\x16\x5b\x0a\x97\xc2\x9a\xd6\x08\xe1\x36\x69\x57\xbe\x2b\x62\xa3\x04\x26\x0a\xdf\xd6\x9b\x24\xe2\x8d\x8e\x57\xd0\x6c\xbd\xda\x6c\x67\x59\xf0\x55\xf1\xc6\xfa\x78\xd8\x67\xb1\xa1\xfe\x6d\x01\x17\xef\x86\x75\x71\xd3\xe1\x39\x94\x95\x06\x4d\x18\xc1\x11\xc3\x09\xd1\xcb\x00\x22\xa4\xc6\xf5\x14\x34\x50\x2d\xbe\x89\xa0\xbc\xff\x02\x55\xa3\xdc\x11\xc1\x30\x18\x37\xc0\x24\x2a\x5e\x44\x5b\x2f\xb3\xce\xc5\xce\x7b\x0a\x7c\xfb\x9a\xec\x87\x7f\x0e\xaf\x7c\xd9\x19\x43\x94\xc4\x76\xb0\xce\x7a\x2c\x8b\x2e\x58\x49\x25\xb4\x8a\xc7\xe5\x96\xdd\x9e\xcf\x8d\x9f\xcf\x77\x5f\x37\xdb\xd5\x2a\xd3\x18\xab\x60\x86\x5a\xa4\xe2\x07\x79\x79\x22\x0f\x85\x42\xc4\x20\x5c\x50\x2a\x79\xbd\x80\xc6\x74\xa8\xae\x4d\x65\x94\x1d\x11\xdf\xa9\x3a\x09\xc2\xb4\xea\x88\xb3\xec\x37\x0a\x5e\xf9\x8f\xe9\x04\x00\x00"
 
 func pluginsPlexDataManifestYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -523,7 +523,7 @@ func pluginsPlexDataManifestYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "plugins/plex/data/manifest.yml", size: 1054, mode: os.FileMode(493), modTime: time.Unix(1498469905, 0)}
+	info := bindataFileInfo{name: "plugins/plex/data/manifest.yml", size: 1257, mode: os.FileMode(493), modTime: time.Unix(1792321108, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -548,7 +548,7 @@ func pluginsPlexpyDataConfigIni() (*asset, error) {
 	return a, nil
 }
 
-var _pluginsPlexpyDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x53\x41\x8e\x9c\x30\x10\xbc\xf3\x8a\x12\x5c\x43\x50\xae\xbe\xe6\xb4\xb7\xd5\xce\x03\x90\x07\x37\x8b\x15\xe3\xb6\xec\x66\x58\xf2\xfa\xc8\x78\x76\x18\x45\x24\xa7\xe4\x84\xbb\x55\x5d\xaa\xea\x6a\xe8\x23\x70\x22\xd3\xcf\x24\x13\x9b\xa4\xaa\x16\x2f\x3e\x89\x76\xae\x6a\xf1\x46\x49\x74\x94\xaa\xc5\x45\x38\xec\x9f\x5c\x16\x6c\xcf\x41\x2c\xfb\xa4\x2a\x7c\x8e\xe4\x67\x0b\x43\xa3\x5e\x9c\xf4\x37\xed\x16\x52\xb8\x6e\x42\xc9\xfe\x24\x53\x01\xc0\x64\xbd\x28\xd4\xf5\x5e\x78\x3d\x93\xc2\x92\x28\xe6\xd7\xde\x92\x2d\x90\x42\x92\x68\xfd\xfb\x09\xdb\x9f\x38\x82\x4e\x69\xe5\x68\xce\x38\x1e\x03\x2f\x23\x36\x5e\xe0\x48\xdf\x08\x32\xd9\x04\x9a\x83\x6c\xd0\x88\xda\x1b\x9e\x1f\x2c\x58\xad\x73\xb8\x12\x12\x39\x1a\x84\x0c\x46\x8e\x79\xb6\x3e\x33\xd8\x4d\x3c\x53\xf7\xb0\xd9\x0d\xec\x47\xfb\xde\x05\x47\x1f\x61\x7b\xd2\x58\xfa\xfd\xc8\xce\x50\x3c\x17\xaa\x9d\xe3\xb5\x37\xe4\x28\xaf\x56\x41\xe2\x42\x7f\xdf\xc2\x65\x57\x08\x8d\x31\x12\x21\x70\x14\x08\x23\x2e\xbe\x18\x64\xff\xe5\xee\xb7\x58\x15\xc6\x94\x2b\x5d\xa0\xc1\x0e\x3f\x0e\x77\x5f\x9f\xc4\xae\x74\xed\x33\xe4\x4c\xe7\xfd\x2c\x4e\xd3\xbe\xa7\x72\x12\xd1\xc0\x5e\xb4\xf5\x14\x7b\x7b\x1a\xd3\xe5\xbf\x70\x72\xf8\xa7\x94\x05\xf3\x5a\xa2\x8d\x61\xe8\x9f\x1b\x6f\xaf\xdf\xab\x34\xf1\x7a\xfc\x19\xed\x71\xdc\xed\x71\xa3\xed\xb1\xdd\xf6\xb7\xab\xb8\x51\x4c\x7b\xf2\xdf\xaa\x8c\x59\xa2\xeb\x47\x8e\xb3\x16\x85\x49\x24\xa8\xae\x6b\x1a\x1b\x9a\x46\x35\xcd\x27\x47\xd3\x74\x95\xa1\x34\x44\x1b\xca\xd1\xd4\x45\x0d\x6c\x82\xde\x95\x61\x66\x6f\x85\xb3\x01\xe8\x10\x9c\x1d\x74\x46\xd6\xd5\xaf\x00\x00\x00\xff\xff\x6c\x5c\x3c\xcb\xfd\x03\x00\x00"
+var _pluginsPlexpyDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x54\x3d\x93\x9c\x30\x0c\xed\xf9\x15\x1a\x68\x43\x98\xb4\xb4\x57\x5d\xb7\x73\x9b\xd4\x8c\x17\x8b\xb5\x27\xc6\x76\x64\xb1\x1c\xf9\xf5\xb1\xf1\x7e\x90\x1d\x2e\xd5\xa5\x42\xd6\xc7\xd3\x7b\x96\x0c\xbe\x7b\x17\x50\x76\x23\xb2\x72\x32\xb4\x45\x0d\xaf\x36\xb0\x30\x26\x5a\x6f\x18\x2d\xe2\x68\xfd\xf0\x67\x12\x12\x57\x4b\x0a\xc6\x18\x71\x13\xf5\x18\xa2\xe7\x45\x09\x7b\xc6\x83\x08\x61\x76\x24\xa3\xe3\xc8\xce\xaf\x9f\x54\xab\x50\x18\x56\x09\x97\x17\x8f\x2d\x28\x66\x5f\x00\x38\xcf\xda\xd9\x16\x66\x3c\x75\xde\xc5\xbc\x4c\xa0\xcb\xfe\xc8\x03\x6e\x3c\x92\x59\x83\xc4\x41\x4c\x86\xbb\x8b\x30\x53\x44\x39\x2d\x8c\x41\xff\x46\x19\x83\x00\x4a\x5b\x6e\xa1\x2c\xd7\x83\x15\x63\x4c\x98\x02\x52\xb2\x56\x57\xee\x1c\x98\xb4\x3d\xef\xa0\x6d\xca\xfc\x4d\xc5\xa6\x0c\x7b\x42\xde\xf6\x79\x1d\x60\x71\x13\x18\x14\x17\x04\x56\x3a\x00\x8e\x9e\x17\x10\x40\xc2\x4a\x37\xde\x51\x60\xd6\xc6\xc0\x09\x23\x86\xc1\x9e\x51\xc2\xe0\x28\xd5\x96\x7b\x9a\x1a\xe5\x46\x6c\xee\xca\x9a\xde\xd9\x41\x9f\x1b\x6f\xf0\xdd\x2f\x1b\x8e\xd9\xdf\x0d\xce\x48\xa4\x0d\x51\x2f\x58\xad\xc7\x78\x69\x6e\xee\x64\xec\x99\xef\x98\x69\xc2\x8f\x64\x67\x49\xc7\x95\x5f\x14\x30\x10\x22\xa4\x79\x00\x3b\xa0\xc9\x66\x79\xce\x7e\xb9\xaa\xcd\x42\x63\x4c\xa5\x93\xc8\xa9\x5e\xf7\x3f\x1f\xda\xbe\x6e\xa8\xde\xa7\xbb\x61\x99\x8f\xd7\xd5\xda\x1d\xee\x75\x90\x3b\x53\x8d\xd2\x59\x68\x8b\xd4\x69\xb9\x37\xd9\xe3\x7f\xc1\x74\xfe\x93\x21\xff\x7e\x31\x9f\x0c\x0e\x40\xf8\x6b\xd2\x84\xf2\xc3\xc9\x3f\xa1\x7f\x57\x08\x16\xe7\xfb\xda\x6e\xbb\xfd\xfb\x41\x3c\x75\xca\x25\x87\xbc\xb0\xe4\xfb\x6e\xeb\x78\x3b\xbc\x14\x41\xc5\xc5\xbc\x3f\xf1\xfa\xf1\x4a\xeb\x47\xa3\xfa\xb1\x35\xf5\xd3\xae\x5f\x90\xc2\xba\xd1\xdf\x8a\x94\x33\x91\x89\x11\x1a\x05\xe7\xdf\x4a\xdb\x34\x55\xa5\x7d\x55\xb5\x55\x75\xc3\xa8\xaa\xa6\x90\x18\x7a\xd2\xd7\x1f\x4e\x99\xd9\x40\x5c\x6b\xb1\x32\x83\xd1\x59\xcd\x2e\xdd\x1e\x08\xef\x8d\xee\x45\xca\x2c\x8b\x3f\x71\xac\x3c\xef\x1b\x05\x00\x00"
 
 func pluginsPlexpyDataManifestYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -563,12 +563,12 @@ func pluginsPlexpyDataManifestYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "plugins/plexpy/data/manifest.yml", size: 1021, mode: os.FileMode(493), modTime: time.Unix(1498116056, 0)}
+	info := bindataFileInfo{name: "plugins/plexpy/data/manifest.yml", size: 1307, mode: os.FileMode(493), modTime: time.Unix(1792320008, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _pluginsPlexrequestsDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x52\xb1\x72\xdd\x20\x10\xec\xf9\x8a\x9b\xa7\x36\xb2\x26\x2d\x6d\xaa\x74\x1e\xbf\x0f\x60\xb0\x58\x59\x4c\x10\x47\xe0\x78\xb2\xf2\xf5\x19\x21\x3b\x7e\xc9\xa8\x8c\x2b\xe9\x96\x65\xd9\xbb\x5b\xbc\x26\x2e\x70\x66\x81\xcc\xec\x8a\x56\x3d\x7d\x8f\x45\x6c\x08\xaa\xa7\x27\x14\xb1\x59\x54\x4f\x57\xe1\xd4\x3e\x7b\x79\x70\x0d\x27\xf1\x1c\x8b\x56\xf4\x7e\x65\xff\xed\xc9\x61\xb2\x35\x88\xb9\xd9\x50\xa1\x69\x98\x79\xc1\xf0\xbc\x09\x8a\xff\x05\x37\x8c\x1c\x27\xff\x32\xa4\x80\xd7\x8c\x9f\x15\x45\x8a\x22\x22\x8a\x76\x81\xa6\xe3\xd4\x4c\x1c\x1c\x72\xc3\x65\x4b\xd0\x54\x24\xfb\xf8\xd2\x00\x1b\x02\xaf\xc6\x21\x60\x7f\x5f\x93\xe4\x8a\x93\x87\x1b\x77\xf6\x51\x34\x5d\x11\x30\x0a\x59\x9a\x32\x40\x89\xb3\x90\x30\xe5\x1a\x49\x66\x5f\x88\xe3\x17\x0a\xb0\x37\x10\x96\x24\xdb\x7e\x36\xef\x95\x3d\xa8\xc9\x8f\x3f\xe0\x68\xe2\x4c\x1b\xd7\x87\x3b\xb3\x2b\x9e\xcd\x4e\x39\xf3\xf9\x36\xbb\xd3\x91\x5c\x2e\x77\xe6\xde\x8a\x3f\xed\x8b\xf5\x11\xd9\x78\x77\xa6\x7a\xfd\x14\x4d\x4e\xff\x55\xf2\xe0\xfc\xb5\xe0\x9c\x46\x73\xc0\x8f\x77\xf0\xd3\xe3\x37\x55\x66\x5e\x3f\xa2\xd4\x7f\xcc\xb4\xff\x27\x0b\x37\xe4\xd2\xf6\xfd\x55\xed\x9c\x9a\x83\x99\x38\x2f\x56\x34\xcd\x22\x49\x0f\x43\xd7\xf9\xd4\x75\xba\xeb\xde\x35\xba\x6e\x50\x0e\x65\xcc\x3e\x1d\x51\xb9\x5c\xfd\x92\x02\xc8\x56\xe1\xc5\x0a\x1c\xad\x76\x6b\x9b\xad\x05\xb9\xb4\x54\x1c\xde\x28\x62\x6d\x6d\x22\x4a\x23\xec\xbe\x1f\x2e\xea\x77\x00\x00\x00\xff\xff\x45\x72\x35\x59\x30\x03\x00\x00"
+var _pluginsPlexrequestsDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x53\xb1\x72\xdb\x30\x0c\xdd\xf5\x15\x38\x6b\x8d\xad\xeb\xaa\x35\x53\x37\x5f\x7c\x9d\x75\xb4\x08\x99\xbc\x50\x04\x4b\x82\x56\xd4\xaf\x0f\x44\x3a\x8e\xd3\xcb\xd8\x4e\x02\xc0\xc7\x87\xa7\x07\x10\xdf\x02\x25\xd4\xc3\x8c\x6c\x48\xa7\xbe\xd9\xc3\x4f\x9f\x58\x39\x27\xd1\x0b\x4a\x14\x59\xa2\x5f\xe1\x12\x95\xc6\x12\x69\xc5\x28\x27\x94\xe3\x88\x49\x2a\x27\xa6\x50\x3e\x1b\xd4\xa0\x72\x6c\x36\x1a\x5e\x03\xf6\x60\x98\x43\x03\x40\x81\x2d\xf9\x1e\x16\x3c\x0f\x81\x04\x57\xfb\x0d\xb5\x2e\x6d\xe1\xa3\xed\x16\xee\x41\xe3\xa4\xb2\xe3\xe1\xaa\x5c\x16\x96\xce\xd0\x8c\xdd\x79\x65\x4c\xf6\x0f\xea\x6e\x24\x3f\xd9\x4b\x17\x1c\xbe\x45\xfc\x9d\x45\x66\x92\x6b\x00\x5e\xcd\x82\xae\xa7\xc3\x44\x4e\x63\x2c\xf5\xaa\x25\x28\x36\x25\x95\x36\xb4\x0c\x1a\x1d\x56\x55\x1c\x33\x7e\xd3\xb6\x60\x8d\xf5\xdc\xc3\x49\xb0\x23\x83\x82\x29\x22\xc2\xf6\x07\xc0\x04\x31\x7b\x60\x63\x13\x90\x7f\x02\x87\xea\x8a\x80\x73\xe0\x75\x3b\x33\x5b\xa6\x2a\x34\xd8\xf1\x15\x35\x4c\x14\x61\xa5\x7c\x78\x90\x7a\xf7\xe3\x41\x65\x4d\x6f\xde\x7f\x6b\xc7\x6e\xf7\x20\xed\x96\xdc\x7f\x9d\x95\xf5\x18\x07\xab\x1f\x38\x13\x47\xeb\x2f\x52\x38\xfd\x17\x4e\x0a\xff\x94\xb2\x62\xbe\x0c\x37\x86\x71\xa8\xe5\xe3\x43\xf9\xe5\xf8\xdc\x24\x23\xa3\xbc\xaf\xd1\xfe\xd3\xd1\xfd\x5f\x7b\xd0\xc2\x79\xd4\xa0\x09\x13\x78\x62\x78\x45\x0c\x32\x3c\x19\xa6\x4a\x69\xa1\xa8\x81\xa6\x3a\x4c\x15\xc2\x13\x8c\x46\xf9\x0b\x82\x65\xb0\xbe\xc0\xa4\x2a\x59\x42\x37\x1d\x9a\xec\x53\x0e\x5b\x93\xaf\x0f\xe7\xb9\xdc\x39\xde\xf8\x9a\x2b\xc6\x54\xd6\xeb\x47\xb3\x89\xca\xd1\x89\x94\x38\x2b\xae\xaf\xa2\xef\xba\xb6\xb5\xa1\x6d\xfb\xb6\xfd\x10\xdd\xb6\x5d\xa3\x31\x8d\xd1\xde\xde\xcb\xee\x64\x67\x31\x02\x54\x66\x92\x9b\xb2\x44\x8b\x5a\xcb\x22\xe5\x24\xf4\x65\x09\xab\x19\xe0\x71\x29\xbe\xa2\xe7\x02\xd8\x8c\x3a\xec\x9a\x77\xc4\x18\xf2\x1f\xe1\x03\x00\x00"
 
 func pluginsPlexrequestsDataManifestYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -583,12 +583,12 @@ func pluginsPlexrequestsDataManifestYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "plugins/plexrequests/data/manifest.yml", size: 816, mode: os.FileMode(493), modTime: time.Unix(1498116057, 0)}
+	info := bindataFileInfo{name: "plugins/plexrequests/data/manifest.yml", size: 993, mode: os.FileMode(493), modTime: time.Unix(1792321108, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _pluginsPortainerDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x52\xb1\xae\xdb\x30\x0c\xdc\xf5\x15\x44\xbc\xd6\x11\xba\x6a\xed\xd4\xed\xe1\x65\xea\x24\xe8\x59\x74\x24\x44\x16\x05\x8a\x4a\x9a\x7e\x7d\xa1\x38\x69\xd2\xc2\x5b\xdb\xc9\xe6\xf1\xcc\xa3\x8f\x87\xdf\x0b\x55\xf4\x76\x41\x09\xe4\xab\x51\x23\x7c\xcd\x55\x5c\x4a\x6a\x84\x77\xac\xe2\x58\xd4\x08\x07\xa1\x72\x7b\xf4\x72\xe5\x5a\x2a\x12\x29\x57\xa3\xe0\xf1\x49\x7f\x1d\xc1\xe3\xec\x5a\x12\x7b\x76\xa9\xa1\x01\x1d\x68\x41\xfd\x71\x15\xac\xf1\x07\x7a\x3d\x51\x9e\xe3\x51\x17\x62\x71\x31\x23\x2b\x00\x80\xec\x16\x34\xb0\xb6\xec\x4c\xc9\xdf\x71\xb9\x16\x34\x50\x85\x63\x3e\xde\x00\x97\x12\x5d\xac\xc7\x84\x5d\xdc\x80\x70\xc3\x4d\xd5\xb3\x63\xcd\x2d\x6b\x4f\xd3\x09\x79\x5f\x69\x3a\xbd\x28\xf5\x12\xc5\x16\x27\xe1\xaf\x74\x6e\xdc\x10\xb3\x18\x38\x60\xc2\x49\xc0\xc1\xcc\x88\xd0\x7f\x0f\x84\x80\x5b\x06\x09\xb1\x02\xe5\x4f\x90\xd0\x9d\x11\x70\x29\x72\xed\xbd\xd0\x2b\xb7\x52\x4b\x9c\x4e\xe8\x61\x26\x86\x2b\xb5\xfd\xcb\xaa\x17\xfc\xb0\x9d\xb2\xb5\xe7\xfd\x40\x9b\xbe\xef\x76\x2f\xcb\xdd\x8b\x5f\x36\xaf\xd6\xdb\xe8\xb7\xa6\x1e\xfe\xcb\x4c\x2a\xff\x74\xe4\xca\x79\xa6\x88\xcb\x64\x57\xec\xed\x81\xbd\xbf\x7d\x51\x35\xd0\xe5\x99\xd4\xf1\xb7\xc3\x8f\x4f\x6f\xc7\x3f\xb2\x77\x46\xae\xb7\xbb\x7f\x56\x9d\xd3\x38\xd9\x99\x78\x71\x62\x20\x88\x14\xa3\xf5\x30\xc4\x32\x0c\x66\x18\x1e\x33\x86\x41\x2b\x8f\x75\xe2\x58\xd6\xc8\xec\xbe\x51\x63\x70\xa5\xc0\x0b\x0c\x01\x19\xf7\x3b\xf5\x33\x00\x00\xff\xff\xba\x6c\x53\x7c\x78\x03\x00\x00"
+var _pluginsPortainerDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x53\xb1\x6e\xe3\x30\x0c\xdd\xfd\x15\x44\xbc\xd6\x31\x6e\xf5\xda\xe9\xb6\xa0\x41\x87\x9b\x0c\xc5\xa2\x23\xa1\xb2\x28\x48\x74\xd2\xf4\xeb\x8f\xb2\x9c\xd4\x3d\x74\x3a\xdc\x4d\x22\x9f\xe8\xf7\x9e\x49\x0a\xdf\x03\x25\xd4\xfd\x84\x6c\x48\xa7\xae\x6a\xe0\xa7\x4f\xac\x9c\x93\xe8\x05\x25\x8a\x2c\xd1\x6b\x38\x47\xa5\x71\x89\xb4\x62\x94\x1b\x9a\xe3\x80\x49\x90\x23\x53\x58\x8e\x5c\x6a\x50\x39\x36\x99\x86\x6f\x01\x3b\x30\xcc\xa1\x02\xa0\xc0\x96\x7c\x07\x57\x3c\xf5\x81\xa4\xae\xe8\xf5\x05\x17\x59\xb8\xcb\xe6\xb0\x01\x8d\xa3\x9a\x1d\xf7\x17\xe5\x66\x61\x69\x0d\x4d\xd8\x9e\x6e\x8c\xc9\x7e\xa0\x6e\x07\xf2\xa3\x3d\xb7\x99\x49\x59\x8f\x51\xbe\x01\xf0\x6a\x92\xd2\x72\xd5\x8f\xe4\xf4\x8a\x17\x23\x41\xb1\x59\x52\xd1\xa0\x6b\xaf\xd1\x61\xb1\xc4\x71\xc6\x6f\x35\x2f\x2a\xb6\x71\xf6\xad\xa6\xe1\x0d\xe3\x3e\xc9\xb1\xd1\xc9\x29\x72\xff\xa0\xfd\x3b\x95\xa5\xd6\x58\xcf\x1d\x1c\xa5\x76\x60\x50\x30\x46\x44\xc8\xbf\x06\x4c\x20\x06\x80\x8d\x4d\x40\xfe\x09\x1c\xaa\x0b\x02\x4e\x81\x6f\xf9\xce\xe4\x4c\x95\xd2\x60\xc5\x8e\x86\x91\x22\xdc\x68\xde\x6f\x8c\x3e\x5a\xbe\x71\x59\xd2\x75\xbc\xdf\x76\x7c\xb7\xdb\x58\x5b\x93\x47\x83\x4b\xd3\x7b\xab\x37\x9c\x89\xa3\xf5\x67\x01\x8e\xff\x85\x93\xc2\x3f\xa5\x2c\x35\x9f\xfb\x13\xc3\xd0\x17\xec\x70\xc7\x5e\x0e\xcf\x55\x32\x32\xc4\xc7\x8e\x36\x5f\x86\xde\x7c\x76\xb6\xf9\x63\xeb\x6a\x38\x0d\x1a\x34\x61\x02\x4f\x0c\x6f\x88\x41\x86\x28\x43\x55\x29\x5d\x29\x6a\xa0\xb1\x0c\x55\x85\xf0\x04\x83\x51\xfe\x8c\x60\x19\xac\x5f\xca\x04\x95\x2c\xa1\x1b\xf7\xd5\xec\xd3\x1c\xb2\xc8\xd7\x37\xfa\xbc\x7c\x73\x58\xf9\xaa\x0b\xc6\xb4\xac\xd9\x8f\x2a\x9b\x9a\xa3\x13\x2b\x71\x52\x5c\x1e\x60\xd7\xb6\x75\x6d\x43\x5d\x77\x75\x7d\x37\x5d\xd7\x6d\xa5\x31\x0d\xd1\xae\x4f\x73\xf7\x4b\x5e\xf4\xa2\xbd\x81\xc1\x60\xc4\xfd\xae\xfa\x0d\x42\xfe\x5d\x50\x27\x04\x00\x00"
 
 func pluginsPortainerDataManifestYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -603,12 +603,12 @@ func pluginsPortainerDataManifestYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "plugins/portainer/data/manifest.yml", size: 888, mode: os.FileMode(493), modTime: time.Unix(1498116057, 0)}
+	info := bindataFileInfo{name: "plugins/portainer/data/manifest.yml", size: 1063, mode: os.FileMode(493), modTime: time.Unix(1792321108, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _pluginsRadarrDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x93\xcd\xaa\xdc\x30\x0c\x85\xf7\x7e\x0a\x31\xd9\x36\x0d\xdd\x7a\x5b\x28\x74\x51\x28\x33\x0f\x60\x74\x63\x65\x62\xea\x58\x46\x56\x66\x3a\x7d\xfa\xe2\xf8\xfe\xcc\xbd\x84\xfe\x40\xbb\x4a\xa4\x9c\xe8\x3b\x58\xc7\xf4\x3d\x73\x21\xef\x16\xd2\x99\x7d\xb1\xa6\x87\xcf\xa9\x28\xc6\x68\x7a\x38\x52\x51\x14\x35\x3d\x9c\x94\xf3\xf6\xa8\x65\xd3\x3a\xce\x1a\x38\x15\x6b\xe0\xe9\x97\xfa\xda\x83\xa7\x09\xd7\xa8\xee\x82\x71\x25\x0b\xc3\xcc\x0b\x0d\x0f\x37\xa5\x12\x7e\x90\x1f\x46\x4e\x53\x38\x0f\x82\x1e\x45\x0c\x00\x40\xc2\x85\x2c\xb4\xbe\x9b\x38\x7a\x6a\x7d\xbd\x65\xb2\x50\x54\x42\x3a\x6f\x0d\x8c\x91\xaf\xce\x53\xa4\x4a\xb6\xa0\xb2\xd2\x9f\x20\x3d\x2a\x6e\x03\xe6\x90\xd4\xc2\xa7\x0d\x51\x81\x8a\x21\x85\x74\x86\x1b\xaf\x02\xcf\xaa\xe6\xa7\x96\xbf\x70\xf3\x5b\xe8\x42\x3e\xec\x50\xaf\x41\x67\x10\xaa\x0c\x0f\x9b\xe6\x2d\x78\x6b\xfe\x05\xf9\x8e\x71\xa2\x48\xa3\x02\xc2\x24\x44\x90\x59\x14\x94\x41\xd6\x04\x3a\x87\x02\x9c\xde\x41\x24\xbc\x10\xd0\x92\xf5\x56\xbf\xcd\xb5\xc2\x26\xcd\x61\xfc\x46\x1e\x26\x96\x7a\x20\xef\xef\x2c\x5d\xe9\xc1\x55\xc9\x9e\x9d\xc7\x90\xec\xee\xfe\x70\xb8\x33\xf7\x58\x3c\x6f\xbb\x1e\x3e\x89\x0b\x7e\x6f\xea\xe9\xbf\xcc\xe4\xfc\x4f\x47\x36\xcd\xb1\x25\x59\xf2\xe8\xee\x1b\xc7\xaf\x1f\x4d\x99\xf9\xfa\x72\x4f\xfa\x97\x73\xec\x5f\xc5\xab\x7f\x13\xfe\xfe\x75\x06\x2e\x24\x65\xcb\xfb\x07\x53\x07\xac\x12\xdd\xc4\xb2\xa0\x5a\x98\x55\xb3\x1d\x86\xae\x0b\xb9\xeb\x6c\xd7\x3d\x01\xba\x6e\x30\x9e\xca\x28\x21\xb7\xab\x72\x38\x71\x42\x91\x6d\xb9\x5f\xf8\x12\xa8\x1c\xcc\xcf\x00\x00\x00\xff\xff\x84\x4d\x27\x68\xfc\x03\x00\x00"
+var _pluginsRadarrDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x54\xc1\x6e\xdb\x30\x0c\xbd\xfb\x2b\x88\xf8\x5a\xd7\xe8\xd5\xd7\x02\x03\x76\x28\x50\x24\xe8\xd9\x50\x2c\x3a\x12\x6a\x8b\x82\x44\x27\xcd\xbe\x7e\x94\x94\x66\x4e\xd1\xa1\x3d\x6c\x27\x93\xcf\x34\xdf\x13\x1f\x2d\x7c\xf3\x14\x51\xf7\x33\xb2\x21\x1d\xbb\xaa\x81\x9f\x2e\xb2\x9a\x26\x89\xb6\x28\x51\x60\x89\x5e\xfc\x21\x28\x8d\x39\xd2\x8a\x51\xde\xd0\x12\x06\x8c\x82\xec\x98\x7c\x7e\xa4\x52\x83\x6a\x62\x93\xda\xf0\xd9\x63\x07\x86\xd9\x57\x00\xe4\xd9\x92\xeb\xe0\x84\xfb\xde\x93\xd4\x15\xbe\xbe\xe0\x42\x0b\xef\xb4\x29\x6c\x40\xe3\xa8\x96\x89\xfb\xa3\x9a\x16\xe9\xd2\x1a\x9a\xb1\xdd\x9f\x19\xa3\xfd\x85\xba\x1d\xc8\x8d\xf6\xd0\x8a\x24\x15\x82\x7c\x00\xe0\xd4\x2c\x75\x05\xef\x47\x9a\x34\x16\xbc\xa8\xf0\x8a\x4d\x4e\x85\x80\x4e\xbd\xc6\x09\x8b\x1e\x0e\x0b\x7e\x87\x50\xce\xac\x72\x03\x63\x1d\x77\xf0\x23\x13\x24\x3a\x56\xd6\x59\x77\x80\xb3\x8c\x03\xae\x55\x45\x4d\x4a\xff\xaa\xe5\x4b\xca\x19\xb5\xfd\x84\xf3\x64\xd9\x40\xc0\xc4\xa0\x21\xd7\x7c\xa4\xcd\xe0\xb7\x79\x57\x0c\x3b\x19\xcb\xc0\xa0\x60\x0c\x88\x90\x6c\x02\x26\x08\x8b\x03\x36\x36\x02\xb9\x3b\x98\x50\x1d\x11\x70\xf6\x7c\x4e\xef\x4c\xca\x54\x29\xf5\x76\x78\x15\x49\x23\x85\x34\x8c\xfb\x95\xa0\xab\xe9\x2b\x31\x25\xbd\x2c\xd8\xa7\x9e\x6f\x36\x2b\x69\x97\xe4\xea\x72\x1a\x3b\x86\xde\xea\x55\xcf\xc8\x41\x9c\x10\x60\xf7\x5f\x7a\x92\xff\xa7\x2d\x4b\xcd\xb6\x6c\x70\xf0\x43\xbf\x06\xb6\xcf\x8f\x55\x34\xb2\xa9\xd7\xff\xa3\xf9\x33\xc5\xe6\x66\xb1\x9a\x0f\x4b\xdf\xdc\xfa\x5f\xc3\x7e\xd0\xa0\x09\x23\x38\x62\x78\x45\xf4\xe2\xa6\xb8\xab\x62\x3c\x51\xd0\x40\x63\x71\x57\x79\x7f\x07\x83\x51\xee\x80\x60\x19\xac\xcb\x65\x82\x4a\x16\x71\x1a\xef\xab\xc5\xc5\xc5\x27\x05\xb7\xd7\xc5\x63\xfe\xe6\xf9\xd2\xaf\x3a\x62\x88\xf9\xd7\x7a\xa8\x92\xe2\x25\x4c\x22\x25\xcc\x8a\xcb\x5d\xd0\xb5\x6d\x5d\x5b\x5f\xd7\x5d\x5d\xbf\x9f\xa8\xae\xdb\x4a\x63\x1c\x82\xbd\xdc\x12\x9b\x1d\x39\x99\x42\xde\xa5\x27\x3a\x5a\x8c\x9b\xea\x37\x8f\x7d\xaa\xd6\xa9\x04\x00\x00"
 
 func pluginsRadarrDataManifestYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -623,12 +623,12 @@ func pluginsRadarrDataManifestYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "plugins/radarr/data/manifest.yml", size: 1020, mode: os.FileMode(493), modTime: time.Unix(1498116057, 0)}
+	info := bindataFileInfo{name: "plugins/radarr/data/manifest.yml", size: 1193, mode: os.FileMode(493), modTime: time.Unix(1792321108, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _pluginsResilioDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x93\xc1\x92\xdb\x20\x0c\x86\xef\x3c\x85\x26\xbe\x96\x7a\x7a\xe5\xda\x5e\x7a\xeb\x24\x0f\xc0\x10\x23\x07\xa6\x18\x31\x42\x4e\xea\x7d\xfa\x0e\x71\xb6\xd9\xdd\x71\x3a\x39\xb4\x27\x23\xf1\xf3\x09\xc9\x3f\xf8\xab\x50\x45\x6f\x27\x94\x40\xbe\x1a\xa5\xe1\x7b\xae\xe2\x52\x52\x1a\xf6\x58\xc5\xb1\x28\x0d\x07\xa1\x72\xfd\xb4\x70\xd5\x5a\x2a\x12\x29\x57\xa3\xe0\xf5\x48\x5b\x6a\xf0\x38\xba\x39\x89\x3d\xbb\x34\xa3\x81\xe3\x22\x58\xe3\x0b\x7a\x05\x00\x10\x62\x16\x03\xbb\xdd\x35\xc8\x6e\x42\x03\x73\x45\x6e\xab\x6b\x4a\x96\x82\x06\xaa\x70\xcc\xa7\x2d\x5a\x1f\x68\xc2\xfe\x0f\xb3\x1f\x28\x8f\xf1\xd4\x33\xd6\x98\x22\x3d\x2a\xb1\xaa\xec\x48\xc9\x23\x6f\xd5\x01\x70\x29\xd1\xc5\x7a\x4c\xd8\xba\x32\x20\x3c\xe3\x33\x17\xf0\x4e\xdc\xa3\xb2\x6d\xef\x2f\x45\x3f\xb2\xdf\x60\x0e\x98\x70\x10\x70\x30\x32\x22\x14\x62\x01\x21\xe0\x39\x83\x84\x58\x81\xf2\x27\x48\xe8\xce\x08\x38\x15\x59\xda\x5e\x68\x91\x5b\xa5\x25\x0e\x3f\xd1\xc3\x48\x0c\x0b\xcd\x9f\xdf\xdc\xe8\x82\x47\xdb\x24\x4f\xcd\xfa\xd6\xcb\x46\x63\xc5\xd5\x7a\x21\xf6\x5b\x98\x9b\x69\x36\xbd\xf0\x98\x38\x50\x16\x17\x33\xb2\x8d\x9b\xd4\xc3\x7f\x61\x52\xf9\xa7\xc8\x55\xb3\xbf\x79\x91\xcb\x60\xdf\x65\xf6\x3f\xbe\xaa\x1a\xe8\x72\x7f\x39\xfa\x6e\x7e\x7d\x1f\xaa\xbe\xff\x26\xfd\xc1\xba\xfa\x9d\xa7\xce\xc8\xf5\x6a\xd6\x2f\xaa\x9d\x98\x39\xd9\x91\x78\x72\x62\x20\x88\x14\xd3\xf7\x5d\x17\x4b\xd7\x99\xae\x7b\x25\x76\x5d\xaf\x3c\xd6\x81\x63\x59\x7d\xbe\xfb\xe6\xc4\x41\x5d\xf2\x10\x98\x72\x7c\x71\x2d\x0d\x42\x94\xe0\x38\xc7\xe4\x81\x32\x1c\xa3\x10\x33\x66\x01\xc1\x21\x64\x4a\x74\x5a\x76\xea\x77\x00\x00\x00\xff\xff\x22\xc0\x7b\x1d\x3b\x04\x00\x00"
+var _pluginsResilioDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x54\x3d\x73\xdb\x30\x0c\xdd\xf5\x2b\x70\xd6\x5a\x55\xd7\x55\x6b\xba\x74\xcb\xd9\xed\xac\xa3\x45\xd8\xe4\x95\x26\x58\x10\xb2\xa3\xfc\xfa\x40\x92\x3f\x13\xfb\x2e\x43\x3a\x09\x1f\xe4\x7b\x80\x80\x47\x7c\x49\x94\xd1\xb6\x3b\x14\x47\x36\x37\x45\x05\xbf\x62\x16\x13\x82\x5a\x4b\x54\x8b\x45\xad\x3f\x69\xcb\xc6\xe2\x64\x59\x23\xa8\x19\xea\xb9\xc3\xac\x91\x27\x67\xe2\x16\x9f\x4d\xce\x07\x62\xab\x81\x95\x50\x9a\x3e\xe3\x5d\x87\x26\x88\x1b\x71\x65\x48\xd8\x80\x13\x49\x05\x00\x25\xf1\x14\x1b\x38\xe0\xba\x4d\xa4\xe7\xe6\x02\xda\x39\xae\x75\xc0\xa9\x8e\xd1\xac\xc0\xe2\xc6\xf4\x41\xda\xbd\x09\xbd\xa2\xac\x07\xc1\xec\x5f\xd1\x6a\x12\xc0\xf9\x28\x0d\x2c\x16\x93\x13\xcd\x4e\x0f\xf4\x19\x79\xb4\xa6\xd0\xcc\x9c\x85\x7d\xdc\xde\x43\xab\x1d\xed\xb0\x3e\x63\xd6\x1d\xc5\x8d\xdf\xd6\xac\x6e\xf0\xf4\x88\x62\x3e\xd5\x6e\x28\x58\xe4\x2b\x9e\x64\xc4\x4d\xae\x16\x4f\x87\xd6\x62\xc0\xb9\x57\xe1\x1e\x3f\x43\xaf\x3f\xd8\x3c\x22\x1d\x73\x0f\x29\xdf\x23\x5f\x81\xac\xb4\x8a\x4e\xc0\xc0\x86\x11\x61\xfc\xe3\x20\x04\xdc\x47\x10\xe7\x33\x50\xfc\x06\x01\xcd\x1e\x01\x77\x49\x86\x31\xe7\x46\xcf\xcc\x47\x93\xef\xfe\xa2\x85\x0d\x31\x0c\xd4\x7f\xbf\xaa\xe7\x3c\xbf\xab\x62\x66\xf7\x43\x9b\xc7\x3e\xee\x34\x95\x4e\xbb\x73\x35\x2c\xec\x18\x47\x98\xe3\x0e\xde\xdd\x82\xc7\x88\x3a\x1b\x31\x3e\x22\xb7\xde\xde\x5b\x81\xd5\x7f\xc1\xa4\xf4\xc5\x90\xb7\xd2\xfa\x62\x70\x00\xc6\x7f\xbd\x67\xb4\x8f\x57\xf3\x16\xfd\xb7\x43\x88\x78\x38\xcf\xeb\xb3\x33\xfc\xc0\x34\x5f\x59\x1e\xf5\xc5\xa9\x6b\x6f\x22\xcb\xe7\xa7\x22\x3b\xd5\xce\xf9\x35\xa8\x2e\x82\xae\x2e\x54\xd5\x65\xfd\xaa\x77\x72\xac\x6e\x94\xb2\x47\xce\x93\x04\x7f\x14\xe3\x8d\x9e\x83\x66\x78\x67\x64\x7e\x8f\x9a\xba\x2e\x4b\x9f\xca\xb2\x29\xcb\x13\x62\x59\xd6\x85\xc5\xdc\xb1\x3f\xbe\x54\x8b\x9f\x0a\x08\x79\x88\x9d\x63\x8a\xfe\xd5\x8c\x61\x15\x0a\x05\x58\xf7\x3e\x58\xd5\x10\xac\xbd\x10\x33\x46\x15\x17\x76\x2e\x52\xa0\xed\xb0\x28\xde\x00\x8e\x1f\xa2\x7b\x64\x05\x00\x00"
 
 func pluginsResilioDataManifestYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -643,7 +643,7 @@ func pluginsResilioDataManifestYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "plugins/resilio/data/manifest.yml", size: 1083, mode: os.FileMode(493), modTime: time.Unix(1498116058, 0)}
+	info := bindataFileInfo{name: "plugins/resilio/data/manifest.yml", size: 1380, mode: os.FileMode(493), modTime: time.Unix(1792320008, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -668,7 +668,7 @@ func pluginsResilioDataSyncConf() (*asset, error) {
 	return a, nil
 }
 
-var _pluginsRocketchatDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x53\x4d\x8f\xd4\x30\x0c\xbd\xf7\x57\x3c\x4d\xaf\x94\x8a\x6b\x8e\x2c\x42\xe2\x80\x58\xcd\x80\x38\x56\x69\xe3\x4e\xa2\x4d\xe3\x28\x71\x67\x18\x7e\x3d\xca\x7c\xec\x14\xe8\x01\x10\x7b\xaa\xed\x3e\x3f\x3f\xc5\xcf\xf4\x2d\x72\x26\xd3\x4d\x24\x96\x4d\x56\x55\x83\x0f\x21\x8b\xf6\xbe\x6a\xb0\xa5\x2c\x3a\x49\xd5\x60\x27\x1c\xcf\x9f\x92\x5e\xb0\x1d\x47\x71\x1c\xb2\xaa\x70\x6b\x29\x61\x03\x43\xa3\x9e\xbd\x74\x07\xed\x67\x52\xe8\x4f\x42\xd9\x7d\x27\x53\x01\x80\x75\x41\x14\x36\x9b\x73\x12\xf4\x44\x0a\x73\xa6\x54\xa2\x73\x49\x4e\x91\x14\xb2\x24\x17\xf6\x6b\x6c\xad\xe5\x89\xda\x67\xce\x76\xe0\x30\xba\x7d\x9b\x78\x78\x22\x19\xac\x96\xd6\xf4\x8b\x41\x9f\x2d\xe1\x23\x87\x3d\xbf\x7b\x0b\xa3\x45\xf7\x3a\x13\x8e\xce\x7b\xf4\x84\x2c\x9c\xc8\xc0\x52\xa2\xd7\x0b\x3d\x37\x5c\x37\xb2\x37\x94\xfe\x49\x96\x8e\xb1\xd0\x2c\x75\xcd\xd1\xb3\x36\x79\x21\xee\xbd\xf3\x84\x6b\xf9\x4f\x44\xfd\x85\xa0\xc5\x94\x1d\x79\x1a\x04\x1a\x63\x22\x42\xe4\x24\x10\x46\x9a\x03\xc4\xba\x0c\x0e\xaf\xe0\x49\x1f\x08\x34\x45\x39\x95\x7f\xb6\x64\xfa\x02\x8d\x6e\x78\x22\x83\x91\x13\x4e\x3c\x2f\x15\x1d\xa9\xef\x0a\x64\x4d\xce\xd5\x38\xab\x7e\xb8\xee\x7e\xc5\x08\x03\x07\xd1\x2e\x50\xea\x9c\x59\x63\xdd\xbd\x08\x27\xc7\xff\x4a\x79\xc1\x6c\x9f\xf7\x5e\xa5\x38\x74\xbf\x16\xb7\x8f\x0f\x55\xb6\x7c\xbc\xdf\x50\x73\x3f\x83\xe6\xfe\xb4\xcd\x6f\x66\x6c\x7e\x72\xc2\x81\x52\x76\x1c\x14\xde\x54\xa5\x67\x4e\xbe\x1b\x39\x4d\x5a\x14\xac\x48\x54\x6d\x5b\xd7\x2e\xd6\xb5\xaa\xeb\x1b\x67\x5d\xb7\x95\xa1\x3c\x24\x77\x1e\xad\xb0\x29\x37\xf2\xc5\x8b\x9b\xb4\x10\x3e\x45\x0a\xd8\xf1\x9c\x06\xc2\x57\xea\xf1\x60\xb5\xe0\xd1\x6b\x29\xbc\x9b\xea\x47\x00\x00\x00\xff\xff\x98\x5b\x14\x51\x2f\x04\x00\x00"
+var _pluginsRocketchatDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x54\xb1\x6e\xdb\x30\x10\xdd\xf5\x15\x07\x6b\x8d\x23\xb8\x40\x17\x8f\x4d\x51\xa0\x43\x51\xc3\x6e\xd0\x51\xa0\xc4\x93\x49\x84\xe2\x11\xe4\xc9\xae\xfb\xf5\x3d\x4a\xb6\xa3\xb4\x1e\x3c\x34\x93\x8e\xc7\xa7\xf7\x9e\x74\x8f\xc4\x5f\x81\x12\xea\xba\x47\x36\xa4\xd3\xba\x58\xc2\x57\x9f\x58\x39\x27\xd5\x16\xa5\x8a\x2c\xd5\x73\xd8\x47\xa5\x71\xac\xb4\x62\x94\x1d\x1a\x62\x8b\x49\x3a\x3b\xa6\x30\x3e\x32\xd4\xa0\x72\x6c\x32\x0d\x9f\x02\xae\xc1\x30\x87\x02\x80\x02\x5b\xf2\x6b\x38\x62\x53\x07\x12\xdc\xa4\x57\x4f\x7d\x91\x85\x8b\x6c\x2e\x97\xa0\xb1\x53\x83\xe3\xfa\xa0\xdc\x20\x2c\xcd\x89\x31\xd9\xdf\xa8\x65\x13\xc0\x58\xcf\x6b\x58\x2c\xc6\x85\x57\xbd\x00\x86\x84\x31\x57\x63\x6b\x52\x4e\x1c\xad\xdf\xdf\x62\xab\x0c\xf5\x58\x5d\x39\xab\x96\x7c\x67\xf7\x55\xa4\xf6\x05\xb9\x35\x8a\x2b\xdd\xcc\x84\x7e\x18\x84\x6f\xe4\xf7\xf4\xf9\x13\xc8\xb7\xab\x46\x25\x84\xa3\x75\x0e\x1a\x14\x15\x8a\xa8\xc1\x60\xc4\xc7\x99\x9f\x0b\xae\xee\xc8\x69\x8c\x33\x5b\x41\xb1\xb9\xc7\x94\x0a\xf9\x47\xab\xb9\xab\x21\x38\x52\x3a\xcd\xac\x7d\xb1\x0e\xe1\xdc\xbe\xc7\xd2\xdd\x76\x66\x1a\x3b\x74\xd8\x32\x28\xe8\x22\x22\xe4\xe1\x01\x13\xc4\xc1\x03\x1b\x9b\x80\xfc\x03\x38\x54\x07\x04\xec\x03\x9f\xf2\x9e\xc9\x2b\x35\x41\x83\x15\xfb\x1a\x3a\x8a\x70\xa2\x61\xee\xe7\x1a\x85\x99\x99\x69\x79\x8e\xdd\xcd\x24\x9c\xa7\x7e\x23\x02\x32\x45\x56\xd6\x63\xac\xad\xbe\x15\x83\xdd\xbb\x70\x52\xf8\xaf\x94\x13\x66\x7b\x9d\x79\x11\x2f\x07\x2d\xcb\xf4\xd8\x53\x3c\xd5\xce\xf6\x56\x68\x57\xd9\x41\x1b\x86\x3a\x19\x25\x30\xd1\xf9\xb8\xfa\x90\xa5\x82\xd5\xe9\x02\x9a\x7a\x31\xb4\xf5\xdf\xd4\xdb\xcd\x53\x91\x0c\x1d\x5f\xcf\xe0\xf2\xf5\x18\x2d\x5f\xc7\xb3\xfc\x27\xcc\xcb\x37\x59\x2a\xa1\x69\x35\x68\xc2\x04\x9e\x18\x5e\x10\x83\x24\x43\x92\xa2\x52\x3a\x52\xd4\x40\xdd\x94\x14\x49\xf4\x03\x88\xb2\xdf\x23\x58\x06\xeb\x47\x98\x74\x65\x95\xd0\x75\x8f\xc5\xe0\xd3\x10\xb2\xe8\xdb\x0b\xe9\x69\x7c\x67\x73\xe6\x2b\x0e\x18\xd3\x78\x99\xac\x8a\x6c\x72\x88\x4e\xac\xc4\x5e\xf1\x74\xdb\xac\xab\xaa\x2c\x6d\x28\xcb\x75\x59\x5e\x3e\xa2\x2c\xab\x42\x63\x6a\xa3\x3d\xdf\x43\x8b\x7c\xa8\x9f\x1d\x5b\x79\x0d\xe1\x7b\x40\x0f\xbb\xf1\x3f\xc3\x4f\x6c\xb2\x20\xc3\xc6\x29\xce\xbc\x8b\xe2\x0f\xdc\xd0\x9e\xf4\x24\x05\x00\x00"
 
 func pluginsRocketchatDataManifestYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -683,12 +683,12 @@ func pluginsRocketchatDataManifestYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "plugins/rocketchat/data/manifest.yml", size: 1071, mode: os.FileMode(493), modTime: time.Unix(1498116058, 0)}
+	info := bindataFileInfo{name: "plugins/rocketchat/data/manifest.yml", size: 1316, mode: os.FileMode(493), modTime: time.Unix(1792321108, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _pluginsRtorrentDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x93\xbf\x8e\xdb\x30\x0c\xc6\x77\x3f\x05\x11\xaf\x75\x8d\xae\x5a\x3b\xdd\x56\x24\xb7\x1b\x8c\x45\xc7\x42\x65\x51\xa0\xe8\xb8\xe9\xd3\x17\xb2\x93\x38\xd7\x3a\x40\xff\xdd\x64\xd1\x24\x7f\xfc\x08\xe9\xa3\x6f\x91\x13\xd9\x66\x20\xed\xd9\x26\x53\x54\xf0\x12\x92\xa2\xf7\x45\x05\x7b\x4a\x8a\xa2\x45\x05\x07\xe5\x38\x7f\x72\xb8\xd4\x36\x1c\xd5\x71\x48\xa6\x80\x5b\x4b\x3e\x56\x60\xa9\xc3\xd1\x6b\x73\x46\x3f\x92\x81\xe3\x45\x29\xb9\xef\x64\x0b\x00\x80\xde\x05\x35\xb0\xdb\xcd\x41\xc0\x81\x0c\x8c\x89\x24\x9f\xe6\x5f\x7a\x89\x64\x20\xa9\xb8\x70\xda\xa2\xd5\x3d\x0f\x54\xdf\x99\x75\xcb\xa1\x73\xa7\x5a\x94\x45\x28\xe8\xaf\x33\xd0\x7b\x9e\x1a\x4b\x9e\xb2\x5a\x03\x2a\x23\x3d\x0c\x5f\xfa\x9b\x8e\xbd\x25\xf9\x2b\x05\x16\x15\x9f\xad\x96\x73\xff\xc2\x1e\xc8\xba\xa7\xf0\x39\xf9\x07\xf4\x47\xce\x81\x3c\xb5\x0a\x08\x9d\x10\x41\x64\x51\x50\x06\x19\x03\x68\xef\x12\x60\x8c\xc0\xe1\x03\x78\xc2\x33\x01\x0d\x51\x2f\x39\xdf\xe7\x08\x97\xf2\xe8\xda\xaf\x64\xa1\x63\x81\x0b\x8f\x1f\x1f\x85\x4d\x74\x6c\x72\xcd\x6f\xad\x7c\x5d\x69\x63\xbf\x88\x29\x4d\x2c\x76\x0b\x73\x6f\x78\xe9\xf2\xf8\xab\xd0\x59\xfb\xa2\x16\x41\x30\x58\x1e\xee\x14\x98\x9c\xf7\x70\x24\x48\xf3\xea\xab\xf4\x3c\xf2\xfa\xd0\x37\xdf\xef\x73\x81\x2d\x07\x45\x17\x48\x1a\xb7\x29\xf2\xf0\x2e\x4c\x8e\xff\x15\xb9\xd4\xec\x6f\xfe\x91\xd8\x36\x6f\x7f\xed\xbf\x7c\x2e\x52\xcf\xd3\xea\xf7\x6a\xb5\x6c\xb5\xde\x52\xb5\xde\x7b\xf5\x93\xad\xaa\x37\x46\x38\x93\xa4\xd9\x8a\x9f\x8a\xdc\x31\x8a\x6f\x3a\x96\x01\xd5\x40\xaf\x1a\x4d\x5d\x97\xa5\x8b\x65\x69\xca\xf2\x46\x2c\xcb\xba\xb0\x94\x5a\x71\x71\x71\xf1\xee\xb5\x27\xf0\xa8\x94\x14\x64\x7c\x5d\x94\xd6\x72\x3d\x40\x27\x3c\xc0\xc9\x29\x0c\x98\x94\x64\x57\xfc\x08\x00\x00\xff\xff\x95\xc5\xdb\x83\xe7\x04\x00\x00"
+var _pluginsRtorrentDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x55\xc1\x92\xda\x30\x0c\xbd\xf3\x15\x1a\x72\x6d\x9a\xe9\x95\x2b\x9d\x69\xb9\x31\x40\xcf\x19\x13\x2b\xc4\x53\xc7\x76\x65\x05\x4a\xbf\xbe\x76\x1c\x42\xd2\xdd\x9d\x52\x66\x7b\x8a\x6c\xd9\x4f\x4f\x91\xf4\x8c\x3f\x9d\xf5\x28\xcb\x16\xb9\xb1\xd2\xaf\x16\x39\x6c\x8c\x67\xa1\x75\xb0\x76\x18\x2c\xe2\x60\x7d\x73\x27\x12\x12\x7b\x4b\x0a\xc6\xe0\xb1\x1d\x55\xe8\xc3\xce\xba\x11\xe6\x84\x5b\xe1\xfd\xc5\x92\x0c\x1b\x7b\xb6\xae\xff\xc4\xbb\x0d\x0a\xcd\x4d\xc4\xe5\xab\xc3\x15\x34\xcc\x6e\x01\x60\x1d\x2b\x6b\x56\x70\xc1\x63\xe9\x6c\x1f\x23\xf9\xb9\x9a\xba\x95\x61\x24\x23\x74\x3a\x93\x48\x96\xc9\x19\xb8\xc2\x8d\x6b\x34\x73\x90\x58\x8b\x4e\x73\x79\x16\xba\x0b\x48\xc7\x2b\xa3\x57\xbf\x50\x06\x27\x40\x13\xa0\x56\xb0\x5c\xf6\x0b\x23\xda\x70\xa0\xf3\x11\xbb\xc5\x7e\x2b\x45\xf7\x4c\xca\x9c\x5e\x43\x2b\x1a\xdb\x62\x31\x62\x16\x95\x35\xb5\x3a\x15\xc4\x96\x08\x0d\xbf\x8c\x11\x68\xd9\x4b\x29\x51\x63\x4a\x85\xa9\xc3\x49\xf0\x74\xbf\xac\xad\x96\x48\x13\x06\x4e\x70\xf3\x48\xfc\x50\x05\xf1\x56\x62\xd1\xf7\x3c\x72\x8b\x52\xbd\x09\xdd\x3b\x1f\xc6\x9e\xa2\xec\xc3\x9f\xa8\x18\x04\xd4\x84\x08\xb1\x9e\xc0\x16\xa8\x33\xc0\x8d\xf2\x20\x9c\x03\x6b\x3e\x80\x46\x71\x46\xc0\xd6\xf1\x35\xfa\x9b\xb8\x12\xe9\xb8\x53\xd5\x77\x94\x50\x5b\x82\xab\xed\x3e\x4e\x69\x8d\x6d\x34\xa1\x94\x96\x2f\xd2\x1d\xd2\x19\x58\x6d\x23\xb0\xad\x03\x07\x04\x3a\xa4\x5a\xc2\x7e\xfd\x65\x93\x5a\xaf\x16\x15\x3e\xcb\x69\xde\xbb\x4f\x11\x0b\x1d\x9a\xb0\x3f\x7f\x3d\x3c\x4b\x43\x36\xfc\x8f\x0c\xd2\x3d\x77\x1b\xe8\xc9\x74\x60\x45\x38\x6b\xf5\x4d\x1d\xe3\x0d\xcc\xfa\x3a\x26\x7a\x02\x48\x18\x69\xdb\x11\x05\x2e\x4a\x6b\x38\x62\xc0\x88\x6d\x70\xe7\x1a\x43\x0e\x3a\xf3\xea\x14\xcf\xff\xca\x72\x3e\x41\x2c\x94\x41\x2a\xd5\x8c\xe4\x6d\x84\xf7\xff\x05\xd3\xba\x77\x86\x9c\xcb\xe7\x3b\x83\x03\x10\xfe\xe8\x14\xa1\x1c\x05\xe8\x2f\xe8\x87\x30\x08\x06\x2f\x63\xe1\x1e\x6f\x89\x3f\x22\xa5\x2b\xbb\x9b\x3e\x92\xab\xca\xf9\xd6\x6e\xbb\x5e\xf8\x26\x68\xe4\xa8\xe7\xf9\x5d\x92\xf3\x7b\xb0\x7c\xfa\x48\xcc\x65\x33\x9f\x49\xdd\x19\xc9\xf7\x52\xfb\x69\x11\x6f\x74\xa4\x83\x87\x5a\xc1\xe9\xd5\x59\x15\x45\x96\x29\x97\x65\xab\x2c\xbb\x21\x66\x59\xb1\x90\xe8\x2b\x52\xc3\x83\xd3\xe7\xaf\xc3\x0b\xe7\x39\x68\xd3\x20\x08\xc5\xa8\x0c\x35\x85\x9e\x3e\x29\x86\x56\xf8\x30\xdd\xcb\xc5\x6f\xf0\x28\x08\xe4\x40\x07\x00\x00"
 
 func pluginsRtorrentDataManifestYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -703,7 +703,7 @@ func pluginsRtorrentDataManifestYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "plugins/rtorrent/data/manifest.yml", size: 1255, mode: os.FileMode(493), modTime: time.Unix(1498116058, 0)}
+	info := bindataFileInfo{name: "plugins/rtorrent/data/manifest.yml", size: 1856, mode: os.FileMode(493), modTime: time.Unix(1792320008, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -768,7 +768,7 @@ func pluginsSickrageDataConfigIni() (*asset, error) {
 	return a, nil
 }
 
-var _pluginsSickrageDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x54\xc1\x8e\x9c\x30\x0c\xbd\xf3\x15\xd6\x70\x2d\x45\xbd\x72\xab\x5a\x55\x5a\xa9\x95\xaa\x9d\x6a\xaf\xc8\x43\xcc\x10\x6d\x88\x23\xc7\x0c\x9d\x7e\x7d\x15\x98\x19\xd8\x8a\x6e\x77\xa5\xee\x89\x24\xd8\xcf\xcf\xce\x7b\xa1\x9f\x81\x23\x99\xba\x27\xed\xd8\xc4\x2a\x2b\xe0\xce\x47\x45\xe7\xb2\x02\xee\x29\x2a\x8a\x66\x05\xec\x95\xc3\xf4\x49\xdb\x39\xb6\xe6\xa0\x96\x7d\xac\x32\xb8\xa6\xa4\x65\x01\x86\x5a\x1c\x9c\xd6\x27\x74\x03\x55\x70\x38\x2b\x45\xfb\x8b\x4c\x06\x00\xd0\x59\xaf\x15\xec\x76\xd3\xc6\x63\x4f\x15\x0c\x91\x24\xad\xa6\x23\x3d\x07\xaa\x20\xaa\x58\x7f\xdc\x40\xfb\x1b\x46\xc0\x18\x47\x16\xb3\x85\x71\x4b\xb8\x6b\xe1\xcc\x03\x38\xc2\x13\x81\x76\x36\x02\xf5\x41\xcf\x80\x20\xe8\x0d\xf7\x37\x14\x18\xad\x73\x70\x20\x88\xe4\xa8\x51\x32\xd0\xb2\xa4\xdc\xdd\x56\x83\x65\xc7\x3d\x95\xb7\x36\xcb\x86\x7d\x6b\x8f\x65\xb4\xcd\xa3\xe0\x91\x56\x2c\xe7\x3f\x75\xcb\xce\x90\x6c\x53\x45\xe7\x78\xac\x0d\x39\x4a\xc3\xad\x40\x65\xa0\x97\x14\x35\xa8\xb8\xea\xf5\x33\x2a\x2e\x5d\x28\x0b\x19\xe8\x48\xe8\xfd\x8a\x4d\x4a\x79\x86\xcb\x3f\x4b\xf6\x64\x2c\x96\xab\xa2\x5f\x26\x2c\x18\xad\x76\x69\x58\x02\x3d\x9f\x2c\x45\x40\x6f\x40\x4f\x10\x87\xc3\x5c\x2d\xae\x48\x4c\x20\xaf\x60\xb1\xe6\x4f\x6e\x38\x52\xfd\xdc\xd5\xbf\x54\x3e\x17\xa8\x91\x0e\xf5\x20\xee\x95\x48\xfb\x49\x24\x80\xd0\x0a\x11\x04\x16\x05\x65\x90\xc1\xcf\x1a\x63\xff\xee\x22\xb9\x59\x6d\xca\xd0\xa5\x1d\xce\xa1\xc1\x36\x8f\x8b\xc0\xd6\xf7\x93\xd8\xa4\x90\x2d\x3a\x17\x67\x6e\x1a\xee\xd2\xd9\x46\x9b\x0d\x7b\x45\xeb\x49\x6a\xbb\x39\xae\xfd\x9b\x60\x72\xf8\xaf\x90\x73\xcc\xfe\xea\x2e\x09\x4d\xfd\xf4\xe8\xfe\xfb\xa7\x2c\x76\x3c\x2e\x0f\x54\xb1\xbc\x31\xc5\xf2\x54\x14\xcb\x84\x8b\x27\x66\x28\xfe\x30\xea\x89\x24\x4e\x66\xfc\x90\x5d\x14\x52\xb7\x2c\x3d\x6a\x05\x9d\x6a\xa8\xca\x32\xcf\x6d\xc8\xf3\x2a\xcf\xaf\x88\x79\x5e\x66\x86\x62\x23\x36\xcc\x3e\xde\x7d\xf4\x80\x83\x72\x8f\x6a\x1b\x78\xb0\x86\x18\xbe\xda\x83\xa0\x9c\xe1\x1b\x7a\x3c\x92\x4c\x1a\xf8\xf1\x00\xfb\x8e\xc7\xb8\xcb\x7e\x07\x00\x00\xff\xff\xc8\xfa\x33\x95\x9a\x05\x00\x00"
+var _pluginsSickrageDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x55\x4d\x6f\xdb\x30\x0c\xbd\xe7\x57\x10\xf1\x75\x9e\xb1\x6b\x6e\x43\x87\x01\x05\x36\xa0\x68\xba\x5e\x0d\xc5\x62\x2c\x61\xb6\xa4\x51\x74\xb2\xec\xd7\x8f\xb6\xf2\xa1\x14\x6e\x97\x43\x77\x0a\x25\x99\xef\x3d\x4a\x8f\x0c\xfe\x0e\x3e\xa2\xae\x7b\x64\xe3\x75\x5c\x2d\x4a\xb8\x77\x91\x55\xd7\x49\xf4\x88\x12\x11\x4b\xf4\x23\xb4\xa4\x34\x4e\x91\x56\x8c\x72\xe2\x07\x6a\x30\xca\xce\x9d\x51\xae\xc5\x07\x15\xe3\xde\x93\x96\x8d\x35\xfb\x30\xfd\x8c\xb9\x06\x55\xc7\x66\xc4\xe5\x43\xc0\x15\x18\xe6\xb0\x00\xf0\x81\xad\x77\x2b\xd8\xe3\xa6\x0e\x5e\xbe\x4b\x02\xea\xb4\x2f\x3a\xe0\xa4\x63\x0c\x4b\xd0\xb8\x55\x43\xc7\xf5\x4e\x75\x83\xa0\x6c\x0e\x8c\xd1\xfe\x41\x2d\x87\x00\xc6\x3a\x5e\xc1\x72\x39\x2d\x9c\xea\xe5\x83\x21\x22\x8d\xd1\xb4\x95\x98\x23\x93\x75\xed\x0c\x5a\x96\x16\x4e\x55\x64\x69\xd8\x10\x72\xce\x73\xbf\x85\x83\x1f\xa0\x43\xb5\x43\x60\x63\x23\x60\x1f\xf8\x00\x0a\x48\x39\xed\xfb\x33\x0a\xec\x6d\xd7\xc1\x06\x05\xa3\xc3\x86\x51\xc3\xd6\xd3\x98\xbb\x9c\xab\xa9\x32\xbe\xc7\xea\x5c\x59\xd5\x78\xb7\xb5\x6d\x15\x6d\xf3\x93\x54\x8b\x99\xca\x74\x52\x6f\x7d\xa7\x91\x32\xa9\x41\xb1\x99\x96\x72\x6d\x7e\x5f\x6b\x61\x4d\xb7\xcc\x34\xe0\x2d\x94\xf2\xb4\x2a\xab\xf4\x8b\x2c\x2f\x35\xb0\x27\xa9\xc0\x20\xe1\xc7\x4c\xcb\x98\xf2\xaa\x92\x7f\x12\xf6\xa8\xad\xaa\x32\xca\xaf\x13\x92\x90\xb2\x19\x2f\x8a\xa0\xf7\x3b\x8b\x11\xe4\x62\x81\x77\x10\x87\x4d\xe2\x8a\x99\x84\x09\xe4\x66\x0d\xb9\x76\xec\x86\x16\xeb\xb7\x1e\x7d\x36\x7b\xc6\x6f\x47\xa8\xd1\xce\x03\x75\x37\xbb\x2e\x21\xad\x27\x7b\x88\x7f\xb6\x84\x08\x63\x3b\x00\x7b\xa0\xc1\x25\x77\x79\xf7\xe1\x68\xb6\xe4\x33\x39\x33\xe3\x4a\xa5\x4f\x83\x18\xe4\x62\xad\xfc\x6d\xce\xcd\x95\x5d\x4a\x5a\x1e\x3b\x7b\xb6\xb7\x8e\x75\xcd\x14\x29\xbe\x63\x65\x1d\x52\x6d\xf5\x5c\x89\xeb\xff\x82\xe9\xc3\x3b\x43\x5e\x0f\xac\x77\x06\x07\x20\xfc\x35\x58\x69\x95\xd7\xdb\xee\x1a\xfd\xc9\x20\x38\xdc\x9f\xa7\x46\xce\xf6\xf6\x3c\x7a\xc1\x94\x52\xd6\xa7\x79\x41\xa1\xa9\xaf\xb7\x1e\x1f\xee\x16\xd1\xc8\x64\x38\x4f\xd9\xf2\x32\x28\xcb\x0b\x59\x79\x71\x4e\x79\xd5\xe0\xe5\x8b\xd1\xb3\x93\x46\x9c\x06\xcc\xa7\xc5\xd1\xf9\x72\x42\xbd\xe2\x34\xe7\x57\x55\x55\x14\x36\x14\xc5\xaa\x28\x4e\x88\x45\x51\x2d\x34\xc6\x86\xec\xf1\x1f\x60\xf9\xd9\x81\x1a\xd8\x4b\x96\x6d\xe0\xd9\x6a\xf4\xf0\xcd\x6e\x48\xd1\x01\xbe\x2b\x27\xb2\x69\xf2\xf6\xd3\x33\xac\x45\x7b\x5c\x2e\xfe\x02\xa1\xd3\x0a\x08\xb4\x06\x00\x00"
 
 func pluginsSickrageDataManifestYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -783,12 +783,12 @@ func pluginsSickrageDataManifestYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "plugins/sickrage/data/manifest.yml", size: 1434, mode: os.FileMode(493), modTime: time.Unix(1498116059, 0)}
+	info := bindataFileInfo{name: "plugins/sickrage/data/manifest.yml", size: 1716, mode: os.FileMode(493), modTime: time.Unix(1792320008, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _pluginsSonarrDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x93\x4f\x8b\xdc\x30\x0c\xc5\xef\xf9\x14\x62\x72\x6d\x1a\x7a\xf5\xad\x14\x0a\x85\x16\xca\x66\x99\x6b\xd0\xc4\xca\xd8\xd4\xb1\x8c\xac\x4c\x9a\x7e\xfa\xe2\x64\xff\xcc\x2e\x43\xff\x40\x7b\x9a\x91\x22\xbf\xdf\x43\x7e\xa6\xef\x89\x33\xd9\x7e\x22\x75\x6c\xb3\xa9\x1a\xf8\x14\xb3\x62\x08\x55\x03\x77\x94\x15\x45\xab\x06\x3a\xe5\xb4\xfd\x94\x72\x9f\xed\x39\xa9\xe7\x98\x4d\x05\x8f\x47\xca\xdf\x06\x2c\x8d\x38\x07\xed\x2f\x18\x66\x32\xd0\x3a\x9e\xa8\x3d\xad\x4a\xd9\xff\x20\xdb\x0e\x1c\x47\x7f\x6e\x33\x47\x14\xa9\x00\x00\x22\x4e\x64\x60\xef\xf7\x23\x07\x4b\x7b\x5f\xd7\x44\x06\xb2\x8a\x8f\xe7\xad\x81\x21\xf0\xd2\x5b\x0a\x54\xc8\x06\x54\x66\xfa\x13\xa4\x45\xc5\x4d\xc0\xf9\xa8\x06\x3e\x6e\x88\x02\x54\xf4\xd1\xc7\x33\xac\x3c\x0b\x3c\x4d\xed\x7e\x4a\xf9\x0b\x37\xbf\x85\x4e\x64\xfd\x0d\xea\xe2\xd5\x81\x50\x61\x58\xb8\x3f\xbe\xa6\x6e\xa7\xfe\x02\x7b\x05\xe8\x28\xd0\xa0\x80\x30\x0a\x11\x24\x16\x05\x65\x90\x39\x82\x3a\x9f\x81\xe3\x1b\x08\x84\x17\x02\x9a\x92\xae\xe5\x9b\x2b\x15\xee\xa3\xc9\x0f\xdf\xc8\xc2\xc8\x52\xb6\xf1\xf6\xca\xd2\x42\xa7\xbe\x8c\xdc\xb2\xf3\x90\x90\x9b\x17\x7f\x38\x5c\x99\x7b\x28\x9e\xae\xba\x6c\x9e\xa4\xf7\xf6\x96\x6a\xf7\x5f\x34\x39\xfd\x53\xc9\x7d\xa6\xdb\x63\x2c\x69\xe8\xaf\x1b\x77\x5f\x3f\x54\xd9\xf1\xf2\xfc\x48\x9a\xe7\x3d\x36\x2f\xb2\xd5\xbc\x4a\x7e\xf3\x32\x03\x17\x92\xbc\x85\xfd\x5d\x55\x04\x66\x09\xfd\xc8\x32\xa1\x1a\x70\xaa\xc9\xb4\x6d\x5d\xfb\x54\xd7\xa6\xae\x1f\x01\x75\xdd\x56\x96\xf2\x20\x3e\xed\xef\xe4\xf0\x1e\xac\x1f\x47\x12\x8a\x0a\x38\x2b\x4f\xa8\x7e\x80\xa3\xb7\xc4\xf0\xd9\x9f\x04\x65\x85\x2f\x18\xf1\x4c\xb2\x25\xe0\xfe\x08\x9d\xe3\x25\x1f\xaa\x9f\x01\x00\x00\xff\xff\x1a\x5c\xda\xe5\x20\x04\x00\x00"
+var _pluginsSonarrDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x54\xc1\x6e\xdb\x30\x0c\xbd\xfb\x2b\x88\xf8\x5a\xd7\xd8\xd5\xb7\xa1\xc0\x80\x01\x1b\x50\xd4\x6d\xaf\x86\x62\xd1\x96\x50\x5b\x14\x24\x3a\x59\xf6\xf5\xa3\xac\x34\x73\x8a\x0e\xeb\x61\x3b\x99\xa4\x29\xbe\x27\x3e\x52\xf8\xc3\x53\x44\xdd\xcd\xc8\x86\x74\x6c\x8a\x0a\xbe\xba\xc8\x6a\x9a\xc4\x7a\x40\xb1\x02\x8b\xf5\xe4\xc7\xa0\x34\xae\x96\x56\x8c\xf2\x87\x96\xd0\x63\x94\x48\xcb\xe4\xd7\x4f\x4a\x35\xa8\x26\x36\xa9\x0c\x9f\x3c\x36\x60\x98\x7d\x01\x40\x9e\x2d\xb9\x06\x8e\xb8\xef\x3c\x49\x5e\xc6\xeb\x72\x5c\x60\xe1\x15\x36\x99\x15\x68\x1c\xd4\x32\x71\x77\x50\xd3\x22\x55\x6a\x43\x33\xd6\xfb\x13\x63\xb4\x3f\x51\xd7\x3d\xb9\xc1\x8e\x75\x24\xa7\x42\x90\x03\x00\x4e\xcd\x92\x97\xe3\xdd\x40\x93\xc6\x1c\xcf\x2c\xbc\x62\xb3\xba\x02\x40\xc7\x4e\xe3\x84\x99\x0f\x87\x05\x3f\x02\x28\x77\x56\x6b\x01\x63\x1d\x37\xf0\x65\x05\x48\x70\xac\xac\xb3\x6e\x84\x93\xb4\x03\x2e\x59\x99\x4d\x72\xff\xc8\xe5\xaf\x90\x33\x6a\xfb\x0e\xe6\xd1\xb2\x81\x80\x09\x41\xc3\xe3\xf3\x5b\xcc\xf5\xd4\x87\x41\x37\xe5\x5b\xe9\x49\xcf\xa0\x60\x08\x88\x90\x34\x02\x26\x08\x8b\x03\x36\x36\x02\xb9\x1b\x98\x50\x1d\x10\x70\xf6\x7c\x4a\xff\x4c\xf2\x54\x4e\xf5\xb6\x7f\x11\x3e\x03\x85\xd4\x89\xdb\x0d\xa1\x8b\xe2\x1b\x32\xd9\x3d\x4f\xd7\xbb\x82\xef\x76\x1b\x6a\x67\xe7\x22\x71\xea\x39\x86\xce\xea\x4d\xcd\xc8\x41\x64\x90\x40\xfb\x5f\x6a\x92\xff\xa7\x25\x73\x4e\x9b\xc7\x37\xf8\xbe\xdb\x06\x1e\xee\xef\x8a\x68\x64\x4c\x2f\xcb\x51\xfd\xee\x62\x75\x35\x55\xd5\x9b\x89\xaf\xae\xf5\x2f\x61\xdf\x6b\xd0\x84\x11\x1c\x31\xbc\x20\x7a\x51\x53\xd4\x55\x31\x1e\x29\x68\xa0\x21\xab\xab\xbc\xbf\x81\xde\x28\x37\x22\x58\x06\xeb\xd6\x34\x89\x8a\x17\x71\x1a\x6e\x8b\xc5\xc5\xc5\x27\x06\xd7\x6f\xc5\xdd\x7a\xe6\xfe\x5c\xaf\x38\x60\x88\xeb\x5e\x7d\x2a\x12\xe3\x25\x4c\x42\x25\xcc\x8a\xf3\x43\xd0\xd4\x75\x59\x5a\x5f\x96\x4d\x59\xbe\xde\xa8\x2c\xeb\x42\x63\xec\x83\x3d\x3f\x11\xbb\xcf\xa0\xed\x30\xa0\xcc\xb8\x8c\xe3\xc2\x24\xc7\x6d\x0f\xcf\x56\x23\xc1\x37\xbb\x0f\x2a\x9c\xe0\xbb\x72\x6a\x94\x6d\x48\x03\x27\x4b\xd0\x4a\xbb\xe2\xae\xf8\x05\x58\xa7\x74\x2e\xcd\x04\x00\x00"
 
 func pluginsSonarrDataManifestYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -803,12 +803,12 @@ func pluginsSonarrDataManifestYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "plugins/sonarr/data/manifest.yml", size: 1056, mode: os.FileMode(493), modTime: time.Unix(1498116059, 0)}
+	info := bindataFileInfo{name: "plugins/sonarr/data/manifest.yml", size: 1229, mode: os.FileMode(493), modTime: time.Unix(1792321108, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _pluginsSubsonicDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x54\xc1\x8e\xd3\x40\x0c\xbd\xe7\x2b\xac\xe6\xca\x10\x71\x9d\x1b\x82\xcb\x4a\x20\xa1\x2d\xda\x6b\xe4\x66\x9c\x66\xc4\x64\x3c\xf2\x38\x0d\xe5\xeb\x51\x92\x76\xd3\xa2\xb0\xb0\x12\x9c\x32\x9e\xd8\xef\xd9\x2f\x7e\xa1\xef\x89\x33\xb9\xba\x27\xed\xd8\x65\x5b\x18\x78\x88\x59\x31\x84\xc2\xc0\x23\x65\x45\xd1\xc2\xc0\x5e\x39\xcd\x8f\x29\x5c\x72\x6b\x4e\xea\x39\x66\x5b\xc0\xb5\x64\x3a\x1a\x70\xd4\xe2\x10\xb4\x3e\x61\x18\xc8\xc2\xe1\xac\x94\xfd\x0f\x72\x05\x00\x40\xe7\xa3\x5a\xd8\xed\xe6\x20\x62\x4f\x16\x86\x4c\x32\x9d\xe6\x2b\x3d\x27\xb2\x90\x55\x7c\x3c\x6e\xa1\x55\x1d\xf7\x54\x3d\x63\x56\x0d\xc7\xd6\x1f\xab\x3c\x1c\x32\x47\xdf\xdc\xc0\x2e\x6f\xea\x96\x83\x23\xd9\xc2\x06\xc0\x10\x78\xac\x1d\x05\x9a\x26\xb1\xa0\x32\xd0\xdf\x90\xf6\xe4\x3c\xde\x8c\xf3\x11\x15\x61\xf4\x21\xc0\x81\x20\x2b\x0b\x39\xe8\x48\xe8\xed\x4d\x3b\x73\xcd\x0b\xdd\xfc\x91\xd4\xa1\xbe\x96\x73\x2a\x79\x05\xe5\xef\x3e\x50\xc2\x9c\x47\x16\xb7\x2d\xe2\xa5\xe0\xa1\x85\x33\x0f\x10\x08\x4f\x04\xda\xf9\x0c\xd4\x27\x3d\x03\x82\x60\x74\xdc\x3f\xa3\xac\x4d\x53\xa0\x46\xc9\x41\xcb\x32\xd5\xee\x5e\x6e\x69\x3f\xa7\x03\x42\x2b\x44\x90\x58\x14\x94\x41\x86\xb8\xb0\x71\x7c\x73\x21\x5f\x78\x95\xa1\x9b\x22\x5c\x52\x93\x6f\xbe\xad\x54\xb7\x22\x8d\x74\xa8\xa7\x94\xad\xe9\x2e\x06\xd8\xdc\xeb\x8b\x44\x1b\x7a\x35\x1c\x15\x7d\x24\xa9\xfd\xa6\x66\xfb\xff\x82\xc9\xe9\x9f\x42\x2e\x39\xfb\xab\xaf\x24\x35\xf5\xfd\xd5\xe3\x97\x0f\x45\xee\x78\x5c\xff\x03\x66\xb5\xb2\x59\x97\xc6\xac\x0a\x9b\xbb\x8d\x34\xbf\x58\xd4\xdc\x7b\xe4\x44\x92\x67\x57\xbe\x2b\x26\x80\x41\x42\xdd\xb2\xf4\xa8\x16\x3a\xd5\x64\xab\xaa\x2c\x7d\x2a\x4b\x5b\x96\x57\x82\xb2\xac\x0a\x47\xb9\x11\x9f\x16\x43\xef\xde\x47\xc0\x41\xb9\x47\xf5\x0d\x3c\x79\x47\x0c\x9f\xfc\x41\x50\xce\xf0\x19\x23\x1e\x49\xe6\x95\xf8\xfa\x04\xfb\x8e\xc7\xbc\x2b\x7e\x06\x00\x00\xff\xff\x7a\x6c\x17\x35\x10\x05\x00\x00"
+var _pluginsSubsonicDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x54\xc1\x6e\xdb\x30\x0c\xbd\xfb\x2b\x88\xf8\x5a\xd7\xd8\xd5\xb7\xa1\xbb\x14\xd8\x80\xa2\xd9\x7a\x35\x64\x8b\x8e\x84\xda\xa2\x20\xd1\xc9\xb2\xaf\x1f\x6d\x25\xb1\x33\xa4\x58\x07\x74\x27\x53\x14\xc9\xf7\x4c\x3e\x0a\x7f\x7a\x8a\xa8\xeb\x01\xd9\x90\x8e\x55\x56\xc0\xa3\x8b\xac\xfa\x5e\xac\x67\x14\x2b\xb0\x58\x3f\xfc\x2e\x28\x8d\xb3\xa5\x15\xa3\xdc\xd0\x18\x5a\x8c\xe2\xd9\x32\xf9\xf9\x33\x85\x1a\x54\x3d\x9b\xa9\x0c\x1f\x3d\x56\x60\x98\x7d\x06\x40\x9e\x2d\xb9\x0a\x0e\xd8\xd4\x9e\x24\x2e\xe1\xd5\xc9\x2f\xb0\x70\x86\x9d\xcc\x02\x34\x76\x6a\xec\xb9\xde\xab\x7e\x94\x2a\xcd\x91\x31\xda\x5f\xa8\xe5\x12\xc0\x58\xc7\x15\x6c\x36\xf3\xc1\xa9\x41\x02\xc6\x88\x61\xb2\x66\x57\x42\x8e\x1c\xac\xdb\xdd\xaa\x56\x1a\x1a\xb0\xbc\xd4\x2c\x5b\x72\x9d\xdd\x95\x71\x6c\x22\x39\xdb\xae\xca\xa6\x9b\xba\xa3\x5e\x63\x58\xd5\xf6\x8a\xcd\x7c\x14\xc2\x74\xa8\x35\xf6\x98\xfe\x8f\xc3\x88\xef\x81\x1c\x50\x5b\xb5\xfa\x99\x2f\x8a\x15\x1c\x6c\xdf\x43\x83\xc2\x9c\x02\x6a\x30\x18\xf0\x7e\x45\x66\xce\x79\x93\xcb\x5f\x21\x65\x6c\xff\x8a\x38\xa5\xbc\x1b\x70\x95\xe7\x55\x8c\x07\x0a\x7a\x3d\x0d\x6c\x03\xf2\x7a\x7c\x8f\x1d\x1c\x69\x84\x1e\xd5\x1e\x81\x8d\x8d\x80\x83\xe7\x23\x28\x08\xca\x69\x1a\x2e\x55\x16\x96\xd2\xe6\x96\x85\x67\x47\x61\xca\xdd\xbc\xc5\x22\x21\x6c\xe7\x70\xa9\xd7\x05\x44\x98\x54\x07\x4c\x10\x46\x97\xd0\xc8\xdd\x9d\xc0\x13\xae\xdc\x99\xe9\xa4\x52\xa8\xb7\xed\xeb\x02\xb5\xee\xca\x45\xc3\xab\x96\xa4\xe3\x69\x5f\x6e\x4a\xf8\x24\xd7\x1b\xda\x15\x91\xb1\xb2\x0e\x43\x6d\xf5\x2d\xfd\x6e\xff\x4b\x4d\xf2\x1f\x5a\x32\xc5\x6c\xcf\x2b\x14\x7c\x5b\x5f\xbb\x9e\x9f\x1e\xb2\x68\x64\x59\x2e\x2b\x5f\x2c\x5b\x5b\x2c\x92\x29\x96\xfe\x16\x57\x02\x2c\xfe\xd8\xc6\xe2\x7a\x21\x72\x68\x5a\x0d\x9a\x30\x82\x23\x86\x57\x44\x2f\x73\xc6\x45\x45\xd4\xa5\xb9\x2b\xef\xef\xa0\x35\xca\xed\x10\x2c\x83\x75\x73\x98\x78\xe5\x24\x0a\xeb\xee\xb3\xd1\xc5\xd1\x4f\x0c\xae\xdf\xc5\x87\x39\xe7\xe9\x4c\x74\x8f\x21\xce\x3b\xff\x29\x9b\x18\x8f\xa1\x17\x2a\x61\x50\x9c\x1e\xbd\xaa\x2c\xf3\xdc\xfa\x3c\xaf\xf2\xfc\xfc\x47\x79\x5e\x66\x1a\x63\x1b\xec\xe9\x39\xdc\x7c\x76\xa0\x46\x26\xc9\xb2\x2d\xbc\x58\x8d\x04\x5f\x6d\x13\x54\x38\xc2\x37\xe5\xd4\x0e\xc3\xac\xc0\xef\x2f\xb0\x95\xde\xc5\x4d\xf6\x1b\x5e\x65\x05\x92\xb0\x05\x00\x00"
 
 func pluginsSubsonicDataManifestYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -823,7 +823,7 @@ func pluginsSubsonicDataManifestYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "plugins/subsonic/data/manifest.yml", size: 1296, mode: os.FileMode(493), modTime: time.Unix(1498116059, 0)}
+	info := bindataFileInfo{name: "plugins/subsonic/data/manifest.yml", size: 1456, mode: os.FileMode(493), modTime: time.Unix(1792321108, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -848,7 +848,7 @@ func pluginsSyncthingDataConfigXml() (*asset, error) {
 	return a, nil
 }
 
-var _pluginsSyncthingDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x93\xc1\x72\xdc\x2c\x0c\xc7\xef\x7e\x0a\xcd\xfa\xfa\xf1\x79\x7a\xe5\xda\x53\x4f\xed\xc4\x0f\xe0\x61\x41\x0e\x4c\x30\x62\x84\xd8\xad\xf3\xf4\x1d\xec\x24\x9b\xee\x38\x9d\x1c\xda\x93\x11\xfc\xf5\x13\xc2\x7f\xe1\xcf\x4c\x05\xdd\xb4\xa0\x78\x72\x45\x77\x0a\xbe\xa5\x22\x26\xc6\x4e\xc1\x03\x16\x31\x2c\x9d\x82\x51\x28\x6f\x9f\x16\xee\xda\x89\xb2\x04\x4a\x45\x77\xf0\x9a\xd2\x96\x0a\x1c\xce\xa6\x46\x99\x2e\x26\x56\xd4\x70\x5e\x05\x4b\x78\x46\xd7\x01\x00\xf8\x90\x44\xc3\xe9\xb4\x05\xc9\x2c\xa8\xa1\x16\xe4\xb6\xda\xb6\x64\xcd\xa8\xa1\x08\x87\xf4\x78\x44\x1b\x3c\x2d\x38\xbc\x31\x07\x4b\x69\x0e\x8f\x43\x59\x93\x15\xbf\xe7\x1c\x16\xd9\x75\xd3\x4c\xd1\x21\x1f\x55\x02\x30\x31\xd2\x75\x72\x18\xb1\xf5\xa5\x41\xb8\xe2\x67\xae\xe0\x8c\x98\x8f\xca\xb6\xb3\x3f\x14\xbd\x67\xbf\xc3\x8c\x18\xd1\x0a\x18\x98\x19\x11\x32\xb1\x80\x10\x70\x4d\x20\x3e\x14\xa0\xf4\x1f\x44\x34\x17\x04\x5c\xb2\xac\xed\xcc\xb7\xc8\xec\xd2\x1c\xec\x13\x3a\x98\x89\x61\xa5\xfa\xff\xbb\x1b\x5d\xf1\x3c\x35\xc9\xa7\x5e\xfb\xa5\x97\x83\xc6\xb2\x29\xe5\x4a\xec\x8e\x30\x2f\xb6\x39\x74\xc3\xc7\x44\x4b\x49\x4c\x48\xc8\x53\x38\xa4\x8e\xff\x84\x49\xf9\xaf\x22\x77\xcd\xf8\xe6\x46\xce\x76\xba\xdb\x7b\xf8\xf1\xb5\x2b\x9e\xae\xb7\xf9\x51\xb7\x11\x50\xb7\x87\x55\xb7\x5f\xa5\xee\xec\xab\x7e\xf3\xd5\x05\xb9\x6c\x86\xfd\xd2\xb5\x8c\xca\x71\x9a\x89\x17\x23\x1a\xbc\x48\xd6\xc3\xd0\xf7\x21\xf7\xbd\xee\xfb\x57\x62\xdf\x0f\x9d\xc3\x62\x39\xe4\xdd\xeb\xa7\xef\x19\x13\x8c\x54\xd9\xe2\x06\x87\x36\x51\x9e\x29\x85\x67\xd3\x24\x60\x92\x83\xb3\xb1\x4f\xaa\x66\x10\xa2\x78\xea\x7e\x05\x00\x00\xff\xff\xcb\x68\xa5\xdf\x3b\x04\x00\x00"
+var _pluginsSyncthingDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x54\x4d\x93\x9b\x30\x0c\xbd\xf3\x2b\x34\xe1\x5a\xca\xf4\xca\x75\x4f\x3d\x75\x67\x69\xcf\x8c\x83\x45\xec\x59\xb0\xbd\xb2\x9c\x94\xfd\xf5\xb5\x21\x21\x24\x4b\x66\xf6\xb0\x3d\xa1\x2f\x3f\x49\x48\x4f\xf8\xd7\x59\x8f\xb2\x19\x90\x95\x95\xbe\xca\x0a\xf8\x69\x3c\x8b\xbe\x8f\xd2\x0b\x46\x89\x38\x4a\x7f\xdc\x81\x84\xc4\x49\x92\x82\x31\x7a\x6c\xa0\x16\x7d\xb4\x3c\x29\x61\x0e\xf8\x2c\xbc\x3f\x59\x92\xd1\x50\xb3\x75\xd3\x27\xbd\x55\x28\x7a\x56\x09\x97\x47\x87\x15\x28\x66\x97\x01\x58\xc7\xda\x9a\x0a\x4e\xb8\x6f\x9c\x8d\x71\x73\x01\xcd\x6c\x8f\x75\xc0\xa5\x8e\x24\x16\x20\xb1\x13\xa1\xe7\xe6\x28\xfa\x10\x51\xf6\x23\xa3\xd7\xef\x28\xa3\x13\x40\x69\xc3\x15\xec\x76\x93\x62\xc4\x10\x03\x82\x47\x4a\xd2\x64\x9a\x33\x7b\x26\x6d\x0e\x5b\x68\xa5\xb2\x03\x96\x0b\x66\xd9\x5a\xd3\xe9\x43\xe9\x47\xd3\xb2\x9a\xdf\x6c\x26\x99\xe3\x9a\xce\xf6\x12\x69\x95\xc9\x09\x56\x93\x1a\xcb\xb7\xa7\x46\x62\x8f\x73\xb7\x4c\x01\x3f\x53\x40\xfc\xc5\xe2\x51\xd2\xe4\x7b\x98\xf2\x1e\x79\x05\x52\xc7\x2a\x5a\x06\x01\x1d\x21\x42\xfa\xe7\xc0\x16\x28\x18\x88\x3d\x7a\xb0\xe6\x1b\xf4\x28\x8e\x08\x38\x38\x1e\x93\x4f\x25\x4d\xcc\xa1\x4e\xb7\xaf\x28\xa1\xb3\x04\xa3\x0d\xdf\x57\xf5\x2c\x13\x5c\x15\x33\xab\x1f\xda\x3c\xf7\xb1\xd1\x94\xbb\x6c\xcf\x6a\x5c\xd8\x12\x26\x98\xf3\x16\x6e\xee\xc1\x63\xc4\x38\x1b\x16\xda\x20\x35\x5a\x6e\x2d\x41\xfd\x5f\x30\xad\xfb\x62\xc8\x5b\x72\x7d\x31\x38\x00\xe1\x5b\xd0\x84\xf2\xf1\x6a\xde\xa2\xff\x56\x08\x06\x4f\xcb\xbc\x3e\x3b\xc3\x0f\x99\xe6\x27\xf5\xc2\x30\x72\x6d\x73\x67\x7b\x79\x7e\xca\xbc\x8a\xfc\x59\x6e\x42\x71\xa5\x75\x71\x4d\x57\x5c\x57\xb0\xb8\xa3\x64\x71\xc3\x96\x23\x92\x9f\x68\xf8\x23\x4b\x2f\x02\xf5\xd1\x43\x83\xe0\xf9\x2a\x55\x65\x99\xe7\xda\xe5\x79\x95\xe7\x17\xc4\x3c\x2f\x33\x89\xbe\x25\x7d\xbe\x57\xbb\x5f\x0e\x0d\xd4\xd3\xf1\x9b\xc0\x21\x5d\x09\x45\xd6\xe8\x77\x91\x42\x40\x18\x09\x7b\xd1\xbe\x16\xc1\x45\x12\xd9\x7e\x97\xfd\x03\x02\xce\x9f\x2f\x64\x05\x00\x00"
 
 func pluginsSyncthingDataManifestYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -863,12 +863,12 @@ func pluginsSyncthingDataManifestYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "plugins/syncthing/data/manifest.yml", size: 1083, mode: os.FileMode(493), modTime: time.Unix(1498116059, 0)}
+	info := bindataFileInfo{name: "plugins/syncthing/data/manifest.yml", size: 1380, mode: os.FileMode(493), modTime: time.Unix(1792320008, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _pluginsVncDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x53\xc1\x8e\xd4\x30\x0c\xbd\xe7\x2b\xac\x46\xda\x13\x9d\x2e\x07\x0e\x44\x1a\x71\xd8\xd3\x5e\x10\x9a\x91\xf6\x5a\x65\x1a\x77\x1b\x6d\x1a\x47\x89\xdb\x32\x7c\x3d\x4a\x3b\xb3\x1d\x41\x41\x20\xc1\x9e\x1a\xbb\xcf\x7e\x4e\x9e\x1f\x7e\x0d\x94\xd0\xd4\x3d\x72\x47\x26\x29\x51\xc2\xa3\x4f\xac\x9d\x13\x25\x1c\x30\xb1\x8e\x2c\x4a\x38\x32\x85\xf9\x93\xc3\x05\x5b\x53\x60\x4b\x3e\x29\x01\xd7\x92\x7c\x2c\xc1\x60\xab\x07\xc7\xf5\xa8\xdd\x80\x0a\xaa\x8e\x7a\xac\x4e\x67\xc6\x64\xbf\xa1\xa9\x1a\xf2\xad\x7d\xae\x46\xdf\x08\x00\x00\xaf\x7b\x54\xb0\x24\xeb\x96\x9c\xc1\x38\xe7\xf9\x1c\x50\x41\xe2\x68\xfd\xf3\x9c\xd0\xce\xd1\x54\x1b\x74\x98\x69\x15\x70\x1c\xf0\x4f\xf8\x6e\x58\x8c\x66\xfd\x57\x1c\xad\x76\x69\x8b\x64\x06\x77\xd6\xb3\x82\xa2\xb8\x21\x08\x3a\xa5\x89\xa2\xd9\xee\x7e\x29\x78\x6c\xe1\x4c\x03\x38\xd4\x23\x02\x77\x36\x01\xf6\x81\xcf\xa0\x21\x6a\x6f\xa8\x7f\xed\x02\x93\x75\x0e\x4e\x08\x09\x1d\x36\x8c\x06\x5a\x8a\xb9\xb6\xf8\xfd\x48\xc7\x19\x0e\x1a\xda\x88\x08\x81\x22\x03\x13\xc4\xc1\x2f\x6c\x3a\x04\x20\xff\xee\x32\xc0\xc2\xcd\x04\x5d\x8e\xf4\x02\x0f\xb6\x79\x59\xe9\x76\x37\x37\x9c\xf0\x54\x67\xc8\xd6\x0d\x7f\x92\xe2\xc3\xc7\xfb\xfb\x37\x9a\x6b\xf4\xcd\x2f\xe7\xba\xac\xf1\xe6\x76\x5e\xe4\xdb\xd0\xb2\x21\xcf\xda\x7a\x8c\xb5\xdd\xd4\xf3\xf8\x5f\x7a\x52\xf8\xa7\x2d\x5f\x1f\x47\xc4\xd0\xd4\x4b\xf4\xe4\x9b\xc3\x97\x07\x91\x3a\x9a\x56\x0f\x97\xeb\xee\x96\xeb\x63\x96\xab\xde\xe5\x0f\x1e\x1d\x31\xa6\xd9\x23\xef\x45\xc6\x0c\xd1\xd5\x2d\xc5\x5e\xb3\x82\x8e\x39\xa8\xaa\x92\xd2\x06\x29\x95\x94\xd7\x1e\x52\x66\xd7\xef\x3a\xee\xdd\xa7\x8e\x12\xef\x17\xc4\x5d\xfe\xb7\xbf\x85\xdd\x5d\x67\xd9\x4b\x79\x3d\x4a\x29\x0c\xa6\x26\xda\xb0\x58\xb3\x78\xfa\xfc\x00\x07\xec\x89\x11\x0c\xa6\x17\xa6\x00\x09\xe3\x88\x71\x57\x88\xef\x01\x00\x00\xff\xff\x81\x44\x2a\xfd\xd6\x04\x00\x00"
+var _pluginsVncDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbd\x54\x3d\x6f\xdb\x30\x10\xdd\xf5\x2b\x0e\x12\x90\xa9\xb2\xd2\xa1\x43\x05\x04\x1d\x3c\x65\x29\x82\xb8\xcd\x2a\xd0\xe2\xc9\x24\x42\x91\x2c\x79\xb2\xeb\xfe\xfa\x9e\x44\x7f\xc8\x69\x5c\xb4\x40\xda\x49\xc7\xe3\xf1\xbd\xa7\xfb\xc2\xef\xde\x45\x94\x4d\x8f\xa4\x9c\x8c\x75\x56\xc2\xbd\x8d\x24\x8c\x61\xeb\x11\xd9\x0a\xc4\xd6\x57\xbf\x09\x42\xe2\x64\x49\x41\xc8\x37\x6e\x08\x2d\x46\xf6\x2c\x95\xb0\x1b\x7c\x10\x31\xee\x5c\x90\xec\x58\x91\xf3\xd3\x67\x7c\xab\x50\x18\x52\x23\x2e\xed\x3d\xd6\xa0\x88\x7c\x06\xe0\x3c\x69\x67\x6b\xd8\xe1\xba\xf1\x8e\xe3\x92\x80\x26\xf9\x59\x07\x1c\x75\x8c\x66\x09\x12\x3b\x31\x18\x6a\xb6\xc2\x0c\x8c\x52\x29\xd7\x63\xb5\xde\x13\x46\xfd\x03\x65\xd5\x3a\xdb\xe9\x4d\xb5\xb5\x2d\x47\x03\x58\xd1\x73\x50\x72\x36\x9d\x33\x12\xc3\xe4\x4f\x12\xbc\x20\x35\x1d\x19\xdd\xed\x1a\x89\x06\x93\x18\x0a\x03\xfe\x09\xdb\x8c\x83\x93\x21\xfe\x82\xa1\x13\x26\xbe\x46\x31\x43\xf4\xc7\x44\x9e\xe1\x22\xb6\x01\x69\x72\x28\x6d\xa9\x86\xfc\xbe\x83\xbd\x1b\xc0\xa0\xd8\x22\x90\xd2\x11\xb0\xf7\xb4\x07\x01\x41\x58\xe9\xfa\x13\x0a\xec\xb4\x31\xb0\x46\xc6\x30\xd8\x12\x4a\xe8\x5c\x18\xdf\xe6\xd7\x54\x24\x86\xd5\x14\xce\x78\x5d\x40\x84\xb1\x42\x40\x0e\xc2\x60\x13\x9b\xf0\x1e\x9c\x7d\x77\x10\x90\xb8\xf9\x5e\x8d\x27\x91\xc2\xbd\x6e\x9f\xcf\x74\x8b\xd9\x1f\x9e\x6a\x3e\x4b\x58\x3a\xfe\x92\xf9\x0f\x1f\x6f\x6f\xff\x93\x2a\xee\x9d\x2b\xaa\x0e\x63\xf0\x6a\x23\xe6\xf9\xbc\x2c\xf9\x65\xf7\x91\xd0\x16\x43\xa3\x2f\x6a\x49\x41\xdb\x0d\x3b\x56\xff\x04\xd3\xf9\x37\x86\xbc\x9c\xee\x37\x06\x07\x08\xf8\x6d\xd0\x01\xe5\xf5\xe1\xbb\x44\xff\xa2\x10\x2c\xee\x4e\xfd\x3d\x67\xfb\xfd\xe4\xbc\x60\x3a\x15\x3d\x0b\xbe\x6d\xd2\xe9\xc9\xb6\x8f\x0f\xcb\x2c\x2a\x9e\xd9\xd3\x22\x2a\xcf\xb8\xe5\xb9\x49\xca\x73\x17\x97\x2f\x16\xcd\x16\x43\x9c\x86\xfd\x7d\x36\xc6\x0c\xc1\xf0\x4d\xe8\x05\xa5\xe5\x57\x57\x55\x51\x68\x5f\x14\x75\x51\x1c\x31\x8a\x62\x5c\x5d\x0b\x45\xbd\xf9\xa4\x5c\xa4\xbb\x14\x71\x33\xde\xdd\xcd\xc3\x6e\x8e\x5a\xd8\x7b\x34\x8b\x22\x93\x18\xdb\xa0\x0f\x2b\x35\x7f\xfa\xbc\xe4\x9e\xed\x1d\x21\xe7\x32\x3e\x73\x4f\x70\x1e\x02\xcb\x5a\xe4\xd9\x4f\xd6\x9c\x9a\xc6\xf0\x05\x00\x00"
 
 func pluginsVncDataManifestYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -883,12 +883,12 @@ func pluginsVncDataManifestYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "plugins/vnc/data/manifest.yml", size: 1238, mode: os.FileMode(493), modTime: time.Unix(1498116059, 0)}
+	info := bindataFileInfo{name: "plugins/vnc/data/manifest.yml", size: 1520, mode: os.FileMode(493), modTime: time.Unix(1792320008, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _pluginsZncDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x53\xb1\x8e\xdb\x30\x0c\xdd\xf5\x15\x44\xbc\xd6\x67\x74\xd5\x56\xdc\x94\xad\x48\xb6\x2e\x82\x22\xd1\x67\xa1\xb2\x28\x50\x74\xdc\xdc\xd7\x17\xb2\x93\x3a\x28\x8c\xb6\x43\x6f\xb2\x48\x3c\xbe\x67\x3d\x3d\xe2\x8f\x4c\x05\xbd\x19\x51\x06\xf2\x45\xab\x16\x8e\xa9\x88\x8d\x51\xb5\x70\xc2\x22\x96\x45\xb5\x70\x16\xca\xcb\xa7\x96\x2b\xd6\x50\x96\x40\xa9\x68\x05\x8f\x91\x7a\x6c\xc1\x63\x6f\xa7\x28\xe6\x6a\xe3\x84\x1a\xba\x81\x46\xec\x2e\x37\xc1\x12\xde\xd1\x77\x8e\x52\x1f\xde\xba\xf7\xe4\x14\x00\x40\xb2\x23\x6a\x58\x9b\xa6\xa7\xe8\x91\x97\xbe\xdc\x32\x6a\x28\xc2\x21\xbd\x2d\x0d\x1b\x23\xcd\xc6\x63\xc4\x2a\xab\x41\x78\xc2\x1d\xbd\x05\x3b\x84\x24\x1a\x0e\x87\x27\x85\xa9\x20\xd7\xd3\x3e\xf9\xd3\xc0\xbf\x12\x66\x5b\xca\x4c\xec\xff\x48\x78\xec\xe1\x46\x13\x44\xb4\x57\x04\x19\x42\x01\x1c\xb3\xdc\xc0\x02\xdb\xe4\x69\xfc\xc5\x02\x73\x88\x11\x2e\x08\x05\x23\x3a\x41\x0f\x3d\x71\x9d\xfd\xcb\x2f\x9d\x17\x38\x58\xe8\x19\x11\x32\xb1\x80\x10\xf0\x94\x56\x35\x4a\x9f\xee\xe2\xab\xae\x10\x0c\xb5\xb2\x2b\x34\x07\xf7\x7d\x93\x7a\x79\xba\xdd\x8c\x17\x53\x21\x7b\xb7\xbb\xc7\x62\xf7\xb5\xef\x16\xed\xf8\xe5\x28\x89\x0d\x09\xd9\x84\x5d\xcf\xce\x1f\xc2\x49\xf9\xbf\x52\xae\x98\x9a\x5d\xce\xce\xac\xd5\xb7\xe4\x4e\x5f\x5f\x55\x19\x68\xde\x76\xa2\xdd\x02\xd7\x6e\x51\x69\x37\x5f\xdb\xdf\x32\x7f\x45\x2e\x4b\xae\x3f\xab\x8a\x99\x38\x9a\x9e\x78\xb4\xa2\x61\x10\xc9\xba\xeb\x9a\x26\xe4\xa6\xd1\x4d\xf3\xe0\x68\x9a\x4e\x79\x2c\x8e\x43\x5e\x57\xe2\xf0\x25\x81\xf5\x57\x9b\x1c\x7a\x38\x9e\x5e\xe1\x42\x53\x72\xc8\x2f\x07\xf5\x33\x00\x00\xff\xff\x4f\x8a\x3f\xce\xe8\x03\x00\x00"
+var _pluginsZncDataManifestYml = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x94\x3b\x73\xdb\x30\x0c\x80\x77\xfd\x0a\x9c\xb5\x56\xd1\x65\xd5\xd6\xf3\xe4\x2d\x67\xa7\x4b\x16\x1d\x4d\x42\x26\xaf\x14\xc9\x82\x90\x55\xe7\xd7\x97\x12\xfd\x50\x7c\x4e\xaf\x43\x3a\x09\x00\xc1\x0f\x10\x1e\xc4\xdf\xc1\x47\x54\x6d\x8f\xac\xbd\x8a\x4d\x51\xc1\xc6\x45\x16\xd6\x26\x69\x8b\x49\x22\x4e\xd2\x8f\x70\x20\xa1\x70\x96\x94\x60\x4c\x27\x7e\x20\x89\x31\x59\xd6\x5a\xb8\x03\xbe\x88\x18\x47\x4f\x2a\x19\x76\xec\xc3\xfc\x99\xee\x6a\x14\x96\xf5\xc4\xe5\x53\xc0\x06\x34\x73\x28\x00\x7c\x60\xe3\x5d\x03\x23\xee\xdb\xe0\x93\x5f\x4e\xa0\xcd\xf6\x94\x07\x5c\xf2\x98\xc4\x0a\x14\x76\x62\xb0\xdc\x1e\x85\x1d\x12\xa5\xd6\xbe\xc7\x7a\x7f\x62\x8c\xe6\x1d\x55\x2d\xbd\xeb\xcc\xa1\x7e\x77\x32\x79\x03\x38\xd1\x27\xa7\x6c\x6c\x3b\x6f\x15\xd2\x6c\xcf\x29\x04\xc1\x7a\x56\x13\xdd\x8f\xad\x42\x8b\x39\x19\xa6\x01\x1f\x44\x5b\x20\x87\x88\x34\x49\x0b\x5a\x64\x32\xee\x30\x1b\xb4\x71\xdc\xc0\x6a\xf5\x77\x46\xb8\x54\x6a\xc1\x40\x49\xc8\x4b\xc6\xa6\x83\x93\x1f\xc0\xa2\x38\x22\xb0\x36\x11\xb0\x0f\x7c\x02\x01\x24\x9c\xf2\xfd\x95\x02\xa3\xb1\x16\xf6\x98\x18\x16\x25\xa3\x82\xce\xd3\x74\xf7\xd3\x2c\x72\x84\xdd\xec\x9e\x78\x1d\x21\xc2\xd4\x02\x60\x0f\x34\xb8\x1c\xcd\xbb\x6f\xe7\xe0\x39\x6e\x3a\xd3\x93\x26\xb2\x6b\x30\xf2\xe7\x2d\xd4\xd3\xe2\xef\xae\x0d\x5d\xd4\x3b\xab\xe7\x69\x7a\xd8\xcf\xb9\x66\x1f\x0a\xb8\x68\x22\x0b\xe3\x90\x5a\xa3\x1e\x55\x7d\xf7\x5f\x98\x3e\x7c\x31\xf2\xe3\x92\x7c\x31\x1c\x80\xf0\xd7\x60\x08\xd5\xa7\x33\x7c\x47\x7f\xd5\x08\x0e\xc7\xeb\x14\xad\xfe\x79\x3e\xef\x22\xe5\x2b\xd3\xde\x51\x90\x6d\xd6\xde\x9c\xdc\xbe\xac\x8b\xa8\xd3\x72\x5d\xf7\xb9\xba\xed\x4e\x75\x0b\x51\xdd\xe6\xa5\xba\xdb\xd7\x23\x52\x9c\xb7\xf2\xb9\x98\x7c\x06\xb2\xe9\x84\x7a\xc1\xf9\x0d\x69\xea\xba\x2c\x4d\x28\xcb\xa6\x2c\x2f\x8c\xb2\xac\x0b\x85\x51\x92\x39\xbf\x2e\xab\xef\x0e\x84\x3a\x0a\x27\xd3\xb0\x6e\xb6\x6b\xd8\xfb\x21\xc9\xf4\xb4\x2a\xfe\x00\x0f\x7d\xc7\xaf\xf9\x04\x00\x00"
 
 func pluginsZncDataManifestYmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -903,7 +903,7 @@ func pluginsZncDataManifestYml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "plugins/znc/data/manifest.yml", size: 1000, mode: os.FileMode(493), modTime: time.Unix(1498116060, 0)}
+	info := bindataFileInfo{name: "plugins/znc/data/manifest.yml", size: 1273, mode: os.FileMode(493), modTime: time.Unix(1792320008, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -12,6 +12,16 @@ type Portainer struct {
 	imageName string
 }
 
+func init() {
+	plugins.Register("portainer", func(client *docker.Client) (plugins.Plugin, error) {
+		p, err := New(client)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
 func New(client *docker.Client) (*Portainer, error) {
 	manifest, err := plugins.LoadManifest("portainer")
 
@@ -47,7 +57,7 @@ func (self *Portainer) Install(opts *PortainerOpts) error {
 	}).Debug("Plugin options")
 
 	log.Debugln("Pulling docker image", self.imageName)
-	err = self.DockerClient.PullImage(docker.PullImageOptions{Repository: self.imageName}, docker.AuthConfiguration{})
+	err = self.PullImage(self.imageName, opts)
 	if err != nil {
 		return err
 	}
@@ -64,7 +74,7 @@ func (self *Portainer) Install(opts *PortainerOpts) error {
 	conf := docker.Config{Env: []string{"PUID=" + opts.User.Uid}, Image: self.imageName}
 
 	log.Debugln("Creating docker container")
-	c, err := self.DockerClient.CreateContainer(docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_portainer_" + opts.WebPort})
+	c, err := self.CreateContainer(opts, docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_portainer_" + opts.WebPort})
 
 	if err != nil {
 		return err
@@ -72,7 +82,7 @@ func (self *Portainer) Install(opts *PortainerOpts) error {
 
 	log.Debugln("Starting docker container")
 
-	err = self.DockerClient.StartContainer(c.ID, nil)
+	err = self.StartContainer(c.ID, opts)
 	if err != nil {
 		return err
 	}
@@ -3,7 +3,9 @@ package proxy
 import (
 	log "github.com/Sirupsen/logrus"
 	"github.com/bytesizedhosting/bcd/core"
+	"github.com/bytesizedhosting/bcd/events"
 	"github.com/bytesizedhosting/bcd/plugins"
+	"github.com/fsouza/go-dockerclient"
 	"net/rpc"
 )
 
@@ -15,6 +17,12 @@ type ProxyRPC struct {
 	plugins.Base
 }
 
+func init() {
+	plugins.Register("proxy", func(*docker.Client) (plugins.Plugin, error) {
+		return New(), nil
+	})
+}
+
 func New() *ProxyRPC {
 	return &ProxyRPC{plugins.Base{Name: "Proxy", Version: 1}}
 }
@@ -50,6 +58,7 @@ func (self *ProxyRPC) Add(p *Proxy, res *ProxySlice) error {
 	if found == false {
 		c.Proxies = append(c.Proxies, p)
 		core.WriteConfig("proxies.json", &c)
+		events.Publish(events.TopicProxy, "proxy.added", p)
 	} else {
 		log.Debugf("Source %s already in config, not adding again.", p.Source)
 	}
@@ -72,6 +81,9 @@ func (self *ProxyRPC) Remove(p *Proxy, res *ProxySlice) error {
 		}
 	}
 
+	if len(proxies) != len(c.Proxies) {
+		events.Publish(events.TopicProxy, "proxy.removed", p)
+	}
 	c.Proxies = proxies
 	core.WriteConfig("proxies.json", &c)
 
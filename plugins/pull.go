@@ -0,0 +1,99 @@
+package plugins
+
+import (
+	"encoding/json"
+	"github.com/bytesizedhosting/bcd/jobs"
+	"github.com/fsouza/go-dockerclient"
+	"io"
+	"io/ioutil"
+)
+
+type pullMessage struct {
+	Id             string `json:"id"`
+	Status         string `json:"status"`
+	ProgressDetail struct {
+		Current int64 `json:"current"`
+		Total   int64 `json:"total"`
+	} `json:"progressDetail"`
+}
+
+type layerProgress struct {
+	current int64
+	total   int64
+}
+
+// PullImage pulls the image and reports the download progress of all layers
+// to the job attached to the options.
+func (self *Base) PullImage(imageName string, opts Options) error {
+	job := opts.GetBaseOpts().Job()
+	pullOpts := docker.PullImageOptions{Repository: imageName}
+
+	if job == nil {
+		return self.DockerClient.PullImage(pullOpts, docker.AuthConfiguration{})
+	}
+
+	job.Stage(jobs.StagePull)
+	job.Logf("Pulling %s", imageName)
+	pullOpts.Context = job.Context()
+
+	reader, writer := io.Pipe()
+	done := make(chan bool)
+	go func() {
+		trackPullProgress(job, reader)
+		close(done)
+	}()
+
+	pullOpts.OutputStream = writer
+	pullOpts.RawJSONStream = true
+	err := self.DockerClient.PullImage(pullOpts, docker.AuthConfiguration{})
+
+	writer.Close()
+	<-done
+
+	return err
+}
+
+func trackPullProgress(job *jobs.Job, reader io.Reader) {
+	// Whatever happens, keep reading so the pull is never blocked on us.
+	defer io.Copy(ioutil.Discard, reader)
+
+	layers := map[string]*layerProgress{}
+	statuses := map[string]string{}
+	decoder := json.NewDecoder(reader)
+
+	for {
+		msg := pullMessage{}
+		err := decoder.Decode(&msg)
+		if err != nil {
+			return
+		}
+
+		if msg.ProgressDetail.Total > 0 && msg.Status == "Downloading" {
+			layer, ok := layers[msg.Id]
+			if !ok {
+				layer = &layerProgress{}
+				layers[msg.Id] = layer
+			}
+			layer.current = msg.ProgressDetail.Current
+			layer.total = msg.ProgressDetail.Total
+
+			var current, total int64
+			for _, l := range layers {
+				current += l.current
+				total += l.total
+			}
+			job.Progress(current, total)
+			continue
+		}
+
+		// Only log status changes, the progress messages would flood the log.
+		if msg.Status != "" && statuses[msg.Id] != msg.Status {
+			statuses[msg.Id] = msg.Status
+			if msg.Id != "" {
+				job.Logf("%s: %s", msg.Id, msg.Status)
+			} else {
+				job.Logf("%s", msg.Status)
+			}
+		}
+	}
+}
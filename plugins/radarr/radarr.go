@@ -13,6 +13,16 @@ type Radarr struct {
 	imageName string
 }
 
+func init() {
+	plugins.Register("radarr", func(client *docker.Client) (plugins.Plugin, error) {
+		p, err := New(client)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
 func New(client *docker.Client) (*Radarr, error) {
 	manifest, err := plugins.LoadManifest("radarr")
 
@@ -54,7 +64,7 @@ func (self *Radarr) Install(opts *RadarrOpts) error {
 	}
 
 	log.Debugln("Pulling docker image", self.imageName)
-	err = self.DockerClient.PullImage(docker.PullImageOptions{Repository: self.imageName}, docker.AuthConfiguration{})
+	err = self.PullImage(self.imageName, opts)
 	if err != nil {
 		return err
 	}
@@ -71,7 +81,7 @@ func (self *Radarr) Install(opts *RadarrOpts) error {
 	conf := docker.Config{Env: []string{"PUID=" + opts.User.Uid}, Image: self.imageName}
 
 	log.Debugln("Creating docker container")
-	c, err := self.DockerClient.CreateContainer(docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_radarr_" + opts.WebPort})
+	c, err := self.CreateContainer(opts, docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_radarr_" + opts.WebPort})
 
 	if err != nil {
 		return err
@@ -79,7 +89,7 @@ func (self *Radarr) Install(opts *RadarrOpts) error {
 
 	log.Debugln("Starting docker container")
 
-	err = self.DockerClient.StartContainer(c.ID, nil)
+	err = self.StartContainer(c.ID, opts)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,64 @@
+package plugins
+
+import (
+	"fmt"
+	"github.com/bytesizedhosting/bcd/core"
+	"github.com/fsouza/go-dockerclient"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Constructor creates a plugin, feature plugins can ignore the client.
+type Constructor func(client *docker.Client) (Plugin, error)
+
+var registry = map[string]Constructor{}
+var registryLock sync.Mutex
+
+// Register makes a plugin available under name, plugin packages call it from
+// their init function. Names are case insensitive.
+func Register(name string, constructor Constructor) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	name = strings.ToLower(name)
+	if _, exists := registry[name]; exists {
+		panic(fmt.Sprintf("A plugin named '%s' is already registered", name))
+	}
+	registry[name] = constructor
+}
+
+// Registered returns the names of all registered plugins, sorted.
+func Registered() []string {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+
+	names := []string{}
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Create runs the constructor of the plugin registered under name. The
+// options its manifest declares as secret are kept out of the audit and job
+// logs from then on.
+func Create(name string, client *docker.Client) (Plugin, error) {
+	registryLock.Lock()
+	constructor, ok := registry[strings.ToLower(name)]
+	registryLock.Unlock()
+
+	if !ok {
+		return nil, fmt.Errorf("No plugin named '%s' is registered", name)
+	}
+
+	p, err := constructor(client)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range p.GetManifest().SecretOptions() {
+		core.AddSecretOption(key)
+	}
+	return p, nil
+}
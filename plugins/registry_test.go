@@ -0,0 +1,28 @@
+package plugins
+
+import (
+	"github.com/fsouza/go-dockerclient"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	Register("RegistryTest", func(client *docker.Client) (Plugin, error) {
+		return &Base{Name: "registrytest", Version: 1}, nil
+	})
+
+	found := false
+	for _, name := range Registered() {
+		found = found || name == "registrytest"
+	}
+	if !found {
+		t.Error("Registered plugin is not listed:", Registered())
+	}
+
+	p, err := Create("registryTEST", nil)
+	if err != nil || p.GetName() != "registrytest" {
+		t.Error("Could not create the registered plugin:", err)
+	}
+	if _, err = Create("unknown", nil); err == nil {
+		t.Error("Creating an unknown plugin did not fail")
+	}
+}
@@ -2,7 +2,6 @@ package resilio
 
 import (
 	log "github.com/Sirupsen/logrus"
-	"github.com/bytesizedhosting/bcd/core"
 	"github.com/bytesizedhosting/bcd/plugins"
 	"github.com/fsouza/go-dockerclient"
 	"net/rpc"
@@ -13,6 +12,16 @@ type Resilio struct {
 	imageName string
 }
 
+func init() {
+	plugins.Register("resilio", func(client *docker.Client) (plugins.Plugin, error) {
+		p, err := New(client)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
 func New(client *docker.Client) (*Resilio, error) {
 	manifest, err := plugins.LoadManifest("resilio")
 
@@ -47,7 +56,7 @@ func (self *Resilio) Install(opts *ResilioOpts) error {
 	}).Debug("Plugin options")
 
 	log.Debugln("Pulling docker image", self.imageName)
-	err = self.DockerClient.PullImage(docker.PullImageOptions{Repository: self.imageName}, docker.AuthConfiguration{})
+	err = self.PullImage(self.imageName, opts)
 	if err != nil {
 		return err
 	}
@@ -57,7 +66,7 @@ func (self *Resilio) Install(opts *ResilioOpts) error {
 		return err
 	}
 
-	p, err := core.GetFreePort()
+	p, err := opts.FreePort()
 	if err != nil {
 		return err
 	}
@@ -75,7 +84,7 @@ func (self *Resilio) Install(opts *ResilioOpts) error {
 	conf := docker.Config{Env: []string{"PUID=" + opts.User.Uid}, Image: self.imageName}
 
 	log.Debugln("Creating docker container")
-	c, err := self.DockerClient.CreateContainer(docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_resilio_" + opts.WebPort})
+	c, err := self.CreateContainer(opts, docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_resilio_" + opts.WebPort})
 
 	if err != nil {
 		return err
@@ -83,7 +92,7 @@ func (self *Resilio) Install(opts *ResilioOpts) error {
 
 	log.Debugln("Starting docker container")
 
-	err = self.DockerClient.StartContainer(c.ID, nil)
+	err = self.StartContainer(c.ID, opts)
 	if err != nil {
 		return err
 	}
@@ -92,3 +101,21 @@ func (self *Resilio) Install(opts *ResilioOpts) error {
 
 	return nil
 }
+
+// ChangePassword changes the password of the web UI in sync.conf.
+func (self *Resilio) ChangePassword(containerId string, password string) error {
+	opts := &ResilioOpts{}
+	_, err := self.LoadOptions(containerId, opts)
+	if err != nil {
+		return err
+	}
+
+	opts.Password = password
+
+	err = self.RewriteTemplateLines("plugins/resilio/data/sync.conf", opts.ConfigFolder+"/sync.conf", opts, `,"password"`)
+	if err != nil {
+		return err
+	}
+
+	return self.Restart(&plugins.AppConfig{ContainerId: containerId})
+}
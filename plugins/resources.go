@@ -0,0 +1,188 @@
+package plugins
+
+import (
+	"fmt"
+	"github.com/fsouza/go-dockerclient"
+	"strconv"
+	"strings"
+)
+
+// Resources limit what an app may use. Sizes take a b, k, m or g suffix and
+// cpu_quota is the CPU time in microseconds the app gets per 100ms, 50000
+// is half a core. Empty values are not limited.
+type Resources struct {
+	MemoryLimit string `json:"memory_limit,omitempty"`
+	// Memory plus swap, -1 allows unlimited swap.
+	MemorySwap string `json:"memory_swap,omitempty"`
+	CpuShares  string `json:"cpu_shares,omitempty"`
+	CpuQuota   string `json:"cpu_quota,omitempty"`
+	PidsLimit  string `json:"pids_limit,omitempty"`
+}
+
+// The period cpu_quota is measured against.
+const cpuPeriod = 100000
+
+// limits is Resources parsed, zero means no limit.
+type limits struct {
+	memory     int64
+	memorySwap int64
+	cpuShares  int64
+	cpuQuota   int64
+	pidsLimit  int64
+}
+
+// WithDefaults fills the empty values from defaults.
+func (self Resources) WithDefaults(defaults *Resources) Resources {
+	if defaults == nil {
+		return self
+	}
+	pick := func(value, fallback string) string {
+		if value == "" {
+			return fallback
+		}
+		return value
+	}
+	return Resources{
+		MemoryLimit: pick(self.MemoryLimit, defaults.MemoryLimit),
+		MemorySwap:  pick(self.MemorySwap, defaults.MemorySwap),
+		CpuShares:   pick(self.CpuShares, defaults.CpuShares),
+		CpuQuota:    pick(self.CpuQuota, defaults.CpuQuota),
+		PidsLimit:   pick(self.PidsLimit, defaults.PidsLimit),
+	}
+}
+
+// Validate returns a description of every value that can not be applied.
+func (self Resources) Validate() []string {
+	_, problems := self.parse()
+	return problems
+}
+
+// Apply sets the limits on the host config of a container about to be
+// created.
+func (self Resources) Apply(hostConfig *docker.HostConfig) error {
+	l, problems := self.parse()
+	if len(problems) > 0 {
+		return fmt.Errorf("Invalid resource limits: %s", strings.Join(problems, ", "))
+	}
+
+	hostConfig.Memory = l.memory
+	hostConfig.MemorySwap = l.memorySwap
+	hostConfig.CPUShares = l.cpuShares
+	hostConfig.PidsLimit = l.pidsLimit
+	if l.cpuQuota > 0 {
+		hostConfig.CPUQuota = l.cpuQuota
+		hostConfig.CPUPeriod = cpuPeriod
+	}
+	return nil
+}
+
+func (self Resources) parse() (*limits, []string) {
+	l := &limits{}
+	problems := []string{}
+
+	var err error
+	if l.memory, err = parseSize(self.MemoryLimit, false); err != nil {
+		problems = append(problems, "memory_limit "+err.Error())
+	}
+	if l.memorySwap, err = parseSize(self.MemorySwap, true); err != nil {
+		problems = append(problems, "memory_swap "+err.Error())
+	}
+	if l.cpuShares, err = parseCount(self.CpuShares); err != nil {
+		problems = append(problems, "cpu_shares "+err.Error())
+	}
+	if l.cpuQuota, err = parseCount(self.CpuQuota); err != nil {
+		problems = append(problems, "cpu_quota "+err.Error())
+	}
+	if l.pidsLimit, err = parseCount(self.PidsLimit); err != nil {
+		problems = append(problems, "pids_limit "+err.Error())
+	}
+
+	switch {
+	case l.memorySwap > 0 && l.memory == 0:
+		problems = append(problems, "memory_swap needs a memory_limit")
+	case l.memorySwap > 0 && l.memorySwap < l.memory:
+		problems = append(problems, "memory_swap must be at least the memory_limit")
+	}
+	if l.cpuQuota > 0 && l.cpuQuota < 1000 {
+		problems = append(problems, "cpu_quota must be at least 1000")
+	}
+	// Docker refuses anything below 4MB.
+	if l.memory > 0 && l.memory < 4*1024*1024 {
+		problems = append(problems, "memory_limit must be at least 4m")
+	}
+
+	return l, problems
+}
+
+var sizeUnits = map[string]int64{"b": 1, "k": 1024, "m": 1024 * 1024, "g": 1024 * 1024 * 1024}
+
+// parseSize reads sizes like 512m, unlimited allows -1.
+func parseSize(value string, unlimited bool) (int64, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return 0, nil
+	}
+	if unlimited && value == "-1" {
+		return -1, nil
+	}
+
+	unit := int64(1)
+	if factor, ok := sizeUnits[value[len(value)-1:]]; ok {
+		unit = factor
+		value = value[:len(value)-1]
+	}
+
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("must be a size like 512m or 2g")
+	}
+	return n * unit, nil
+}
+
+func parseCount(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("must be a positive whole number")
+	}
+	return n, nil
+}
+
+// UpdateResources changes the limits of a running container. Empty values
+// are left as they are. Docker can not change the pids_limit of an existing
+// container through this API, that is refused.
+func (self *Base) UpdateResources(containerId string, resources Resources) error {
+	l, problems := resources.parse()
+	if len(problems) > 0 {
+		return fmt.Errorf("Invalid resource limits: %s", strings.Join(problems, ", "))
+	}
+
+	container, err := self.DockerClient.InspectContainer(containerId)
+	if err != nil {
+		return err
+	}
+	if l.pidsLimit > 0 && l.pidsLimit != container.HostConfig.PidsLimit {
+		return fmt.Errorf("The pids_limit of an existing container can not be changed, reinstall the app instead")
+	}
+
+	update := docker.UpdateContainerOptions{
+		Memory:     int(l.memory),
+		MemorySwap: int(l.memorySwap),
+		CPUShares:  int(l.cpuShares),
+	}
+	if l.cpuQuota > 0 {
+		update.CPUQuota = int(l.cpuQuota)
+		update.CPUPeriod = cpuPeriod
+	}
+	// Docker refuses a memory limit above the current swap limit, raise the
+	// swap limit so the app keeps the same amount of swap.
+	current := container.HostConfig
+	if l.memory > 0 && l.memorySwap == 0 && current.MemorySwap > 0 && l.memory > current.MemorySwap {
+		update.MemorySwap = int(l.memory + current.MemorySwap - current.Memory)
+	}
+
+	return self.DockerClient.UpdateContainer(containerId, update)
+}
@@ -0,0 +1,30 @@
+package plugins
+
+import (
+	"github.com/fsouza/go-dockerclient"
+	"testing"
+)
+
+func TestResources(t *testing.T) {
+	manifest, err := LoadManifest("plex")
+	if err != nil || manifest.Resources == nil {
+		t.Fatalf("Expected default resources in the plex manifest: %v", err)
+	}
+
+	hostConfig := &docker.HostConfig{}
+	err = Resources{MemoryLimit: "512m", CpuQuota: "50000"}.WithDefaults(manifest.Resources).Apply(hostConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hostConfig.Memory != 512*1024*1024 || hostConfig.CPUQuota != 50000 || hostConfig.CPUPeriod != cpuPeriod {
+		t.Errorf("Install options should override the defaults, got: %+v", hostConfig)
+	}
+	if hostConfig.CPUShares != 512 || hostConfig.PidsLimit != 1024 {
+		t.Errorf("Expected the manifest defaults to fill the rest, got: %+v", hostConfig)
+	}
+
+	problems := Resources{MemoryLimit: "lots", MemorySwap: "1m", PidsLimit: "-5"}.Validate()
+	if len(problems) != 3 {
+		t.Errorf("Expected 3 problems, got: %v", problems)
+	}
+}
@@ -13,6 +13,16 @@ type Rocketchat struct {
 	imageName string
 }
 
+func init() {
+	plugins.Register("rocketchat", func(client *docker.Client) (plugins.Plugin, error) {
+		p, err := New(client)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
 func New(client *docker.Client) (*Rocketchat, error) {
 	manifest, err := plugins.LoadManifest("rocketchat")
 
@@ -64,7 +74,7 @@ func (self *Rocketchat) Install(opts *RocketchatOpts) error {
 	}
 
 	log.Debugln("Pulling docker image", self.imageName)
-	err = self.DockerClient.PullImage(docker.PullImageOptions{Repository: self.imageName}, docker.AuthConfiguration{})
+	err = self.PullImage(self.imageName, opts)
 	if err != nil {
 		return err
 	}
@@ -83,7 +93,7 @@ func (self *Rocketchat) Install(opts *RocketchatOpts) error {
 	conf := docker.Config{Env: []string{"PUID=" + opts.User.Uid}, Image: self.imageName}
 
 	log.Debugln("Creating docker container")
-	c, err := self.DockerClient.CreateContainer(docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_rocketchat_" + opts.WebPort})
+	c, err := self.CreateContainer(opts, docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_rocketchat_" + opts.WebPort})
 
 	if err != nil {
 		return err
@@ -91,7 +101,7 @@ func (self *Rocketchat) Install(opts *RocketchatOpts) error {
 
 	log.Debugln("Starting docker container")
 
-	err = self.DockerClient.StartContainer(c.ID, nil)
+	err = self.StartContainer(c.ID, opts)
 	if err != nil {
 		return err
 	}
@@ -12,6 +12,16 @@ import (
 
 const imageName = "bytesized/rutorrent"
 
+func init() {
+	plugins.Register("rtorrent", func(client *docker.Client) (plugins.Plugin, error) {
+		p, err := New(client)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
 func New(client *docker.Client) (*Rtorrent, error) {
 	manifest, err := plugins.LoadManifest("rtorrent")
 	if err != nil {
@@ -48,7 +58,7 @@ func (self *Rtorrent) Install(opts *RtorrentOpts) error {
 	var ports []string
 
 	for i := 0; i < 4; i++ {
-		p, err := core.GetFreePort()
+		p, err := opts.FreePort()
 		if err != nil {
 			return err
 		}
@@ -106,7 +116,7 @@ func (self *Rtorrent) Install(opts *RtorrentOpts) error {
 	}
 
 	log.Debugln("Pulling docker image", imageName)
-	err = self.DockerClient.PullImage(docker.PullImageOptions{Repository: imageName}, docker.AuthConfiguration{})
+	err = self.PullImage(imageName, opts)
 	if err != nil {
 		return err
 	}
@@ -119,14 +129,14 @@ func (self *Rtorrent) Install(opts *RtorrentOpts) error {
 	}
 
 	log.Debugln("Creating docker container")
-	c, err := self.DockerClient.CreateContainer(docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_rtorrent_" + opts.WebPort})
+	c, err := self.CreateContainer(opts, docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_rtorrent_" + opts.WebPort})
 
 	if err != nil {
 		return err
 	}
 
 	log.Debugln("Starting docker container")
-	err = self.DockerClient.StartContainer(c.ID, nil)
+	err = self.StartContainer(c.ID, opts)
 	if err != nil {
 		return err
 	}
@@ -135,3 +145,20 @@ func (self *Rtorrent) Install(opts *RtorrentOpts) error {
 
 	return nil
 }
+
+// ChangePassword replaces the entry of the user in the htpasswd file nginx
+// checks the web interface logins against.
+func (self *Rtorrent) ChangePassword(containerId string, password string) error {
+	opts := &RtorrentOpts{}
+	_, err := self.LoadOptions(containerId, opts)
+	if err != nil {
+		return err
+	}
+
+	err = core.CreateHttpAuth(path.Join(opts.ConfigFolder, "/.htpasswd"), opts.Username, password)
+	if err != nil {
+		return err
+	}
+
+	return self.Restart(&plugins.AppConfig{ContainerId: containerId})
+}
@@ -13,6 +13,16 @@ type Sickrage struct {
 	imageName string
 }
 
+func init() {
+	plugins.Register("sickrage", func(client *docker.Client) (plugins.Plugin, error) {
+		p, err := New(client)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
 func New(client *docker.Client) (*Sickrage, error) {
 	manifest, err := plugins.LoadManifest("sickrage")
 
@@ -63,7 +73,7 @@ func (self *Sickrage) Install(opts *SickrageOpts) error {
 	}
 
 	log.Debugln("Pulling docker image", self.imageName)
-	err = self.DockerClient.PullImage(docker.PullImageOptions{Repository: self.imageName}, docker.AuthConfiguration{})
+	err = self.PullImage(self.imageName, opts)
 	if err != nil {
 		return err
 	}
@@ -80,7 +90,7 @@ func (self *Sickrage) Install(opts *SickrageOpts) error {
 	conf := docker.Config{Env: []string{"PUID=" + opts.User.Uid}, Image: self.imageName}
 
 	log.Debugln("Creating docker container")
-	c, err := self.DockerClient.CreateContainer(docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_sickrage_" + opts.WebPort})
+	c, err := self.CreateContainer(opts, docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_sickrage_" + opts.WebPort})
 
 	if err != nil {
 		return err
@@ -88,7 +98,7 @@ func (self *Sickrage) Install(opts *SickrageOpts) error {
 
 	log.Debugln("Starting docker container")
 
-	err = self.DockerClient.StartContainer(c.ID, nil)
+	err = self.StartContainer(c.ID, opts)
 	if err != nil {
 		return err
 	}
@@ -97,3 +107,22 @@ func (self *Sickrage) Install(opts *SickrageOpts) error {
 
 	return nil
 }
+
+// ChangePassword changes web_password, the torrent client password is not ours to
+// change.
+func (self *Sickrage) ChangePassword(containerId string, password string) error {
+	opts := &SickrageOpts{}
+	_, err := self.LoadOptions(containerId, opts)
+	if err != nil {
+		return err
+	}
+
+	opts.Password = password
+
+	err = self.RewriteTemplateLines("plugins/sickrage/data/config.ini", opts.ConfigFolder+"/config.ini", opts, "web_password =")
+	if err != nil {
+		return err
+	}
+
+	return self.Restart(&plugins.AppConfig{ContainerId: containerId})
+}
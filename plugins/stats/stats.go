@@ -8,7 +8,9 @@ import (
 	"github.com/shirou/gopsutil/load"
 	"github.com/shirou/gopsutil/mem"
 	"github.com/shirou/gopsutil/net"
+	"github.com/bytesizedhosting/bcd/events"
 	"github.com/bytesizedhosting/bcd/plugins"
+	"github.com/fsouza/go-dockerclient"
 	"net/rpc"
 	"time"
 )
@@ -41,8 +43,40 @@ func (self *Stats) RegisterRPC(server *rpc.Server) {
 	server.Register(self)
 }
 
+// How often a stats sample is published while anybody is subscribed.
+const SampleInterval = 10 * time.Second
+
+// Sample is published on the stats topic.
+type Sample struct {
+	Load   *load.AvgStat          `json:"load"`
+	Memory *mem.VirtualMemoryStat `json:"memory"`
+	Swap   *mem.SwapMemoryStat    `json:"swap"`
+}
+
+func init() {
+	plugins.Register("stats", func(*docker.Client) (plugins.Plugin, error) {
+		return New(), nil
+	})
+}
+
 func New() *Stats {
-	return &Stats{plugins.Base{Name: "stats", Version: 1}}
+	s := &Stats{plugins.Base{Name: "stats", Version: 1}}
+	go s.publishSamples()
+	return s
+}
+
+func (s *Stats) publishSamples() {
+	for range time.Tick(SampleInterval) {
+		if !events.HasSubscribers(events.TopicStats) {
+			continue
+		}
+
+		sample := Sample{}
+		sample.Load, _ = load.Avg()
+		sample.Memory, _ = mem.VirtualMemory()
+		sample.Swap, _ = mem.SwapMemory()
+		events.Publish(events.TopicStats, "stats.sample", sample)
+	}
 }
 
 type NetResult struct {
@@ -17,6 +17,16 @@ type Subsonic struct {
 	imageName string
 }
 
+func init() {
+	plugins.Register("subsonic", func(client *docker.Client) (plugins.Plugin, error) {
+		p, err := New(client)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
 func New(client *docker.Client) (*Subsonic, error) {
 	manifest, err := plugins.LoadManifest("subsonic")
 
@@ -56,7 +66,7 @@ func (self *Subsonic) Install(opts *SubsonicOpts) error {
 		return err
 	}
 	log.Debugln("Pulling docker image", self.imageName)
-	err = self.DockerClient.PullImage(docker.PullImageOptions{Repository: self.imageName}, docker.AuthConfiguration{})
+	err = self.PullImage(self.imageName, opts)
 	if err != nil {
 		return err
 	}
@@ -73,7 +83,7 @@ func (self *Subsonic) Install(opts *SubsonicOpts) error {
 	conf := docker.Config{Env: []string{"PUID=" + opts.User.Uid}, Image: self.imageName}
 
 	log.Debugln("Creating docker container")
-	c, err := self.DockerClient.CreateContainer(docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_subsonic_" + opts.WebPort})
+	c, err := self.CreateContainer(opts, docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_subsonic_" + opts.WebPort})
 
 	if err != nil {
 		return err
@@ -81,7 +91,7 @@ func (self *Subsonic) Install(opts *SubsonicOpts) error {
 
 	log.Debugln("Starting docker container")
 
-	err = self.DockerClient.StartContainer(c.ID, nil)
+	err = self.StartContainer(c.ID, opts)
 	if err != nil {
 		return err
 	}
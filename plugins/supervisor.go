@@ -0,0 +1,239 @@
+package plugins
+
+import (
+	"fmt"
+	log "github.com/Sirupsen/logrus"
+	"github.com/bytesizedhosting/bcd/events"
+	"github.com/fsouza/go-dockerclient"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Restart policies an app can be installed with, set with the
+// restart_policy option. on-failure gives up after restart_max_retries.
+const (
+	RestartNo            = "no"
+	RestartOnFailure     = "on-failure"
+	RestartUnlessStopped = "unless-stopped"
+
+	DefaultRestartPolicy = RestartUnlessStopped
+)
+
+// An app that dies this often within the window is in a crash loop.
+const (
+	DefaultCrashDeaths = 5
+	DefaultCrashWindow = 10 * time.Minute
+)
+
+// A death this soon after a kill was asked for, by a stop for example, is
+// not a crash.
+const killGrace = 30 * time.Second
+
+var restartPolicies = map[string]bool{RestartNo: true, RestartOnFailure: true, RestartUnlessStopped: true}
+
+// DockerRestartPolicy returns the Docker restart policy the options ask for.
+func (self *BaseOpts) DockerRestartPolicy() (docker.RestartPolicy, error) {
+	policy := docker.RestartPolicy{Name: self.RestartPolicy}
+	if policy.Name == "" {
+		policy.Name = DefaultRestartPolicy
+	}
+	if !restartPolicies[policy.Name] {
+		return policy, fmt.Errorf("Unknown restart_policy '%s', use no, on-failure or unless-stopped", policy.Name)
+	}
+
+	if self.RestartMaxRetries != "" {
+		if policy.Name != RestartOnFailure {
+			return policy, fmt.Errorf("restart_max_retries only works with the on-failure restart_policy")
+		}
+		n, err := strconv.Atoi(self.RestartMaxRetries)
+		if err != nil || n < 0 {
+			return policy, fmt.Errorf("restart_max_retries must be a positive whole number")
+		}
+		policy.MaximumRetryCount = n
+	}
+	return policy, nil
+}
+
+// RestartPolicyLabel writes a restart policy the way docker run --restart
+// takes it, like on-failure:3.
+func RestartPolicyLabel(policy docker.RestartPolicy) string {
+	if policy.MaximumRetryCount > 0 {
+		return fmt.Sprintf("%s:%d", policy.Name, policy.MaximumRetryCount)
+	}
+	return policy.Name
+}
+
+// ParseRestartPolicyLabel reads a restart policy written by
+// RestartPolicyLabel.
+func ParseRestartPolicyLabel(label string) (docker.RestartPolicy, error) {
+	opts := &BaseOpts{}
+	parts := strings.SplitN(label, ":", 2)
+	opts.RestartPolicy = parts[0]
+	if len(parts) == 2 {
+		opts.RestartMaxRetries = parts[1]
+	}
+	return opts.DockerRestartPolicy()
+}
+
+// CrashLoop describes an app bcd stopped restarting.
+type CrashLoop struct {
+	Deaths     int       `json:"deaths"`
+	Since      time.Time `json:"since"`
+	DetectedAt time.Time `json:"detected_at"`
+	ExitCode   string    `json:"exit_code,omitempty"`
+	// The policy the app had, it is put back when the app is started again.
+	RestartPolicy docker.RestartPolicy `json:"restart_policy"`
+}
+
+// Supervisor counts how often our containers die. An app that keeps dying
+// has its restart policy turned off and is stopped, so a broken config does
+// not burn CPU restarting forever.
+type Supervisor struct {
+	Deaths int
+	Window time.Duration
+
+	lock   sync.Mutex
+	deaths map[string][]time.Time
+	killed map[string]time.Time
+	loops  map[string]*CrashLoop
+}
+
+// Supervise watches the containers WatchContainers sees.
+var Supervise = NewSupervisor(DefaultCrashDeaths, DefaultCrashWindow)
+
+func NewSupervisor(deaths int, window time.Duration) *Supervisor {
+	return &Supervisor{Deaths: deaths, Window: window, deaths: map[string][]time.Time{},
+		killed: map[string]time.Time{}, loops: map[string]*CrashLoop{}}
+}
+
+// CrashLoop returns a copy of the crash loop a container is in, or nil.
+func (self *Supervisor) CrashLoop(id string) *CrashLoop {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	loop, ok := self.loops[id]
+	if !ok {
+		return nil
+	}
+	res := *loop
+	return &res
+}
+
+// Clear forgets the deaths of a container, it returns the crash loop the
+// container was in so its restart policy can be put back.
+func (self *Supervisor) Clear(id string) *CrashLoop {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	loop := self.loops[id]
+	delete(self.loops, id)
+	delete(self.deaths, id)
+	delete(self.killed, id)
+	return loop
+}
+
+// observe records a container event, it returns a crash loop when the
+// event is the death that starts one.
+func (self *Supervisor) observe(event *ContainerEvent, now time.Time) *CrashLoop {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	id := event.ContainerId
+	switch event.Action {
+	case "kill":
+		self.killed[id] = now
+		return nil
+	case "destroy":
+		delete(self.loops, id)
+		delete(self.deaths, id)
+		delete(self.killed, id)
+		return nil
+	case "die":
+	default:
+		return nil
+	}
+
+	if killed, ok := self.killed[id]; ok && now.Sub(killed) < killGrace {
+		return nil
+	}
+	if self.loops[id] != nil {
+		return nil
+	}
+
+	deaths := []time.Time{}
+	for _, death := range self.deaths[id] {
+		if now.Sub(death) < self.Window {
+			deaths = append(deaths, death)
+		}
+	}
+	deaths = append(deaths, now)
+	self.deaths[id] = deaths
+
+	if len(deaths) < self.Deaths {
+		return nil
+	}
+
+	loop := &CrashLoop{Deaths: len(deaths), Since: deaths[0], DetectedAt: now, ExitCode: event.ExitCode}
+	self.loops[id] = loop
+	return loop
+}
+
+// handle stops restarting a container once it is in a crash loop.
+func (self *Supervisor) handle(client *docker.Client, event *ContainerEvent) {
+	if loop := self.observe(event, time.Now()); loop != nil {
+		go self.stop(client, event, loop)
+	}
+}
+
+func (self *Supervisor) stop(client *docker.Client, event *ContainerEvent, loop *CrashLoop) {
+	log.Warnf("Container %s died %d times in %s, no longer restarting it", event.ContainerName, loop.Deaths, self.Window)
+
+	container, err := client.InspectContainer(event.ContainerId)
+	if err == nil {
+		self.lock.Lock()
+		loop.RestartPolicy = container.HostConfig.RestartPolicy
+		self.lock.Unlock()
+	}
+
+	err = client.UpdateContainer(event.ContainerId, docker.UpdateContainerOptions{RestartPolicy: docker.NeverRestart()})
+	if err != nil {
+		log.Warnf("Could not turn off the restart policy of %s: %s", event.ContainerName, err)
+	}
+	err = client.StopContainer(event.ContainerId, 10)
+	if _, ok := err.(*docker.ContainerNotRunning); err != nil && !ok {
+		log.Warnf("Could not stop %s: %s", event.ContainerName, err)
+	}
+
+	events.Publish(events.TopicContainers, "container.crashloop", struct {
+		*ContainerEvent
+		CrashLoop *CrashLoop `json:"crash_loop"`
+	}{event, self.CrashLoop(event.ContainerId)})
+}
+
+// restore puts back the restart policy of a container that was stopped for
+// crash looping, before it is started again. Crash loops are forgotten when
+// bcd restarts, the policy is then taken from the label of the container.
+func (self *Supervisor) restore(client *docker.Client, id string) error {
+	loop := self.Clear(id)
+	if loop != nil && loop.RestartPolicy.Name != "" {
+		return client.UpdateContainer(id, docker.UpdateContainerOptions{RestartPolicy: loop.RestartPolicy})
+	}
+
+	container, err := client.InspectContainer(id)
+	if err != nil {
+		return err
+	}
+	if container.Config == nil || container.Config.Labels[LabelRestartPolicy] == "" {
+		return nil
+	}
+	policy, err := ParseRestartPolicyLabel(container.Config.Labels[LabelRestartPolicy])
+	if err != nil {
+		return err
+	}
+	if container.HostConfig != nil && container.HostConfig.RestartPolicy == policy {
+		return nil
+	}
+	return client.UpdateContainer(id, docker.UpdateContainerOptions{RestartPolicy: policy})
+}
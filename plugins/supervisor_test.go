@@ -0,0 +1,79 @@
+package plugins
+
+import (
+	"github.com/fsouza/go-dockerclient"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSupervisor(t *testing.T) {
+	supervisor := NewSupervisor(3, time.Minute)
+	now := time.Now()
+	die := &ContainerEvent{ContainerId: "app", Action: "die", ExitCode: "1"}
+
+	// A stop kills the container first, that death does not count.
+	supervisor.observe(&ContainerEvent{ContainerId: "app", Action: "kill"}, now)
+	supervisor.observe(die, now.Add(time.Second))
+
+	// Deaths outside the window are forgotten.
+	supervisor.observe(die, now.Add(time.Hour))
+	supervisor.observe(die, now.Add(2*time.Hour))
+	if loop := supervisor.observe(die, now.Add(2*time.Hour+time.Second)); loop != nil {
+		t.Fatalf("Expected no crash loop yet, got: %+v", loop)
+	}
+
+	loop := supervisor.observe(die, now.Add(2*time.Hour+2*time.Second))
+	if loop == nil || loop.Deaths != 3 || loop.ExitCode != "1" {
+		t.Fatalf("Expected a crash loop after 3 deaths, got: %+v", loop)
+	}
+	if supervisor.CrashLoop("app") == nil {
+		t.Error("Expected the crash loop to be remembered")
+	}
+
+	supervisor.Clear("app")
+	if supervisor.CrashLoop("app") != nil {
+		t.Error("Expected the crash loop to be cleared")
+	}
+
+	policy, err := (&BaseOpts{RestartPolicy: RestartOnFailure, RestartMaxRetries: "3"}).DockerRestartPolicy()
+	if err != nil || policy.Name != RestartOnFailure || policy.MaximumRetryCount != 3 {
+		t.Errorf("Unexpected restart policy %+v: %v", policy, err)
+	}
+	if _, err := (&BaseOpts{RestartMaxRetries: "3"}).DockerRestartPolicy(); err == nil {
+		t.Error("Expected max retries to need the on-failure policy")
+	}
+	if label := RestartPolicyLabel(policy); label != "on-failure:3" {
+		t.Errorf("Unexpected restart policy label '%s'", label)
+	}
+	if parsed, err := ParseRestartPolicyLabel("on-failure:3"); err != nil || parsed != policy {
+		t.Errorf("Label was parsed as %+v: %v", parsed, err)
+	}
+}
+
+func TestSupervisorRestoresFromLabel(t *testing.T) {
+	// A crash looping app after a restart of bcd, only the label remembers
+	// its restart policy.
+	fake := &fakeDocker{replies: map[string]string{
+		"GET /containers/app/json": `{"Id": "app", "Config": {"Labels": {"bytesized.restart_policy": "on-failure:3"}},
+			"HostConfig": {"RestartPolicy": {"Name": "no"}}}`,
+		"POST /containers/app/update": "",
+		"GET /containers/other/json": `{"Id": "other", "Config": {"Labels": {"bytesized.restart_policy": "no"}},
+			"HostConfig": {"RestartPolicy": {"Name": "no"}}}`,
+	}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client, err := docker.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	supervisor := NewSupervisor(3, time.Minute)
+	if err := supervisor.restore(client, "app"); err != nil || !fake.called("POST /containers/app/update") {
+		t.Errorf("Expected the restart policy of the label to be put back: %v", err)
+	}
+	if err := supervisor.restore(client, "other"); err != nil || fake.called("POST /containers/other/update") {
+		t.Errorf("Expected a matching restart policy to be left alone: %v", err)
+	}
+}
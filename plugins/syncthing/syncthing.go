@@ -3,7 +3,6 @@ package syncthing
 import (
 	log "github.com/Sirupsen/logrus"
 	"github.com/fsouza/go-dockerclient"
-	"github.com/bytesizedhosting/bcd/core"
 	"github.com/bytesizedhosting/bcd/plugins"
 	"golang.org/x/crypto/bcrypt"
 	"net/rpc"
@@ -15,6 +14,16 @@ type Syncthing struct {
 	imageName string
 }
 
+func init() {
+	plugins.Register("syncthing", func(client *docker.Client) (plugins.Plugin, error) {
+		p, err := New(client)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
 func New(client *docker.Client) (*Syncthing, error) {
 	manifest, err := plugins.LoadManifest("syncthing")
 	if err != nil {
@@ -54,7 +63,7 @@ func (self *Syncthing) Install(opts *SyncthingOpts) error {
 	var ports []string
 
 	for i := 0; i < 3; i++ {
-		p, err := core.GetFreePort()
+		p, err := opts.FreePort()
 		if err != nil {
 			return err
 		}
@@ -83,7 +92,7 @@ func (self *Syncthing) Install(opts *SyncthingOpts) error {
 	}
 
 	log.Debugln("Pulling docker image", self.imageName)
-	err = self.DockerClient.PullImage(docker.PullImageOptions{Repository: self.imageName}, docker.AuthConfiguration{})
+	err = self.PullImage(self.imageName, opts)
 	if err != nil {
 		return err
 	}
@@ -102,7 +111,7 @@ func (self *Syncthing) Install(opts *SyncthingOpts) error {
 	conf := docker.Config{Env: []string{"PUID=" + opts.User.Uid}, Image: self.imageName}
 
 	log.Infoln("Creating docker container")
-	c, err := self.DockerClient.CreateContainer(docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_syncthing_" + opts.WebPort})
+	c, err := self.CreateContainer(opts, docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_syncthing_" + opts.WebPort})
 
 	if err != nil {
 		return err
@@ -110,7 +119,7 @@ func (self *Syncthing) Install(opts *SyncthingOpts) error {
 
 	log.Infoln("Starting docker container")
 
-	err = self.DockerClient.StartContainer(c.ID, nil)
+	err = self.StartContainer(c.ID, opts)
 	if err != nil {
 		return err
 	}
@@ -119,3 +128,22 @@ func (self *Syncthing) Install(opts *SyncthingOpts) error {
 
 	return nil
 }
+
+// ChangePassword replaces the bcrypt hash of the GUI password.
+func (self *Syncthing) ChangePassword(containerId string, password string) error {
+	opts := &SyncthingOpts{}
+	_, err := self.LoadOptions(containerId, opts)
+	if err != nil {
+		return err
+	}
+
+	opts.Password = password
+	opts.hashPassword()
+
+	err = self.RewriteTemplateLines("plugins/syncthing/data/config.xml", opts.ConfigFolder+"/config.xml", opts, "<password>")
+	if err != nil {
+		return err
+	}
+
+	return self.Restart(&plugins.AppConfig{ContainerId: containerId})
+}
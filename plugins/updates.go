@@ -0,0 +1,220 @@
+package plugins
+
+import (
+	"fmt"
+	log "github.com/Sirupsen/logrus"
+	"github.com/bytesizedhosting/bcd/events"
+	"github.com/fsouza/go-dockerclient"
+	"strings"
+	"sync"
+	"time"
+)
+
+// What happens when a newer image is found for an app, set with the
+// update_policy option. Apps without one are only reported.
+const (
+	PolicyNever  = "never"
+	PolicyNotify = "notify"
+	PolicyAuto   = "auto"
+)
+
+// How often images are checked when the config does not say.
+const DefaultUpdateInterval = 6 * time.Hour
+
+var updatePolicies = map[string]bool{PolicyNever: true, PolicyNotify: true, PolicyAuto: true}
+
+// ImageUpdate is the outcome of checking the image of one app.
+type ImageUpdate struct {
+	ContainerId     string    `json:"container_id"`
+	ContainerName   string    `json:"container_name"`
+	Plugin          string    `json:"plugin,omitempty"`
+	Instance        string    `json:"instance,omitempty"`
+	Image           string    `json:"image"`
+	Policy          string    `json:"policy"`
+	LocalDigest     string    `json:"local_digest,omitempty"`
+	RemoteDigest    string    `json:"remote_digest,omitempty"`
+	UpdateAvailable bool      `json:"update_available"`
+	UpgradeJob      string    `json:"upgrade_job,omitempty"`
+	CheckedAt       time.Time `json:"checked_at"`
+	Error           string    `json:"error,omitempty"`
+}
+
+// UpdateChecker compares the images of the installed apps with the ones the
+// registry serves for the same tag. Apps with the auto policy are upgraded.
+type UpdateChecker struct {
+	client   *docker.Client
+	registry *RegistryClient
+	lookup   func(name string) Plugin
+
+	// Only one check runs at a time.
+	checking sync.Mutex
+
+	lock      sync.Mutex
+	updates   []*ImageUpdate
+	checkedAt time.Time
+}
+
+// NewUpdateChecker creates a checker, lookup finds the enabled plugin an app
+// belongs to so it can be upgraded.
+func NewUpdateChecker(client *docker.Client, registry *RegistryClient, lookup func(name string) Plugin) *UpdateChecker {
+	return &UpdateChecker{client: client, registry: registry, lookup: lookup}
+}
+
+// Run checks right away and then every interval, it never returns.
+func (self *UpdateChecker) Run(interval time.Duration) {
+	for {
+		_, err := self.Check()
+		if err != nil {
+			log.Warnln("Could not check for image updates:", err)
+		}
+		time.Sleep(interval)
+	}
+}
+
+// Updates returns the results of the last check and when it ran.
+func (self *UpdateChecker) Updates() ([]*ImageUpdate, time.Time) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	return self.updates, self.checkedAt
+}
+
+// Check looks up the current digest of every app's image. An update event is
+// published the first time a new digest is seen for an app.
+func (self *UpdateChecker) Check() ([]*ImageUpdate, error) {
+	self.checking.Lock()
+	defer self.checking.Unlock()
+
+	apps, err := FindApps(self.client)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, _ := self.Updates()
+	known := map[string]*ImageUpdate{}
+	for _, update := range previous {
+		known[update.ContainerId] = update
+	}
+
+	// Many apps share an image, the registry is asked only once per check.
+	digests := map[string]string{}
+	failures := map[string]error{}
+
+	updates := []*ImageUpdate{}
+	for _, app := range apps {
+		update := &ImageUpdate{ContainerId: app.ContainerId, ContainerName: app.ContainerName, Plugin: app.Plugin,
+			Instance: app.Instance, Image: app.Image, Policy: appPolicy(app), CheckedAt: time.Now()}
+		updates = append(updates, update)
+		if update.Policy == PolicyNever {
+			continue
+		}
+
+		err := self.checkApp(update, digests, failures)
+		if err != nil {
+			log.Debugf("Could not check the image of %s: %s", app.ContainerName, err)
+			update.Error = err.Error()
+			continue
+		}
+		if !update.UpdateAvailable {
+			continue
+		}
+
+		last := known[update.ContainerId]
+		if last == nil || !last.UpdateAvailable || last.RemoteDigest != update.RemoteDigest {
+			log.Infof("A newer image is available for %s", update.ContainerName)
+			events.Publish(events.TopicUpdates, "update.available", update)
+		}
+
+		if update.Policy == PolicyAuto {
+			// An upgrade that failed is not tried again until the image
+			// changes once more.
+			if last != nil && last.UpgradeJob != "" && last.RemoteDigest == update.RemoteDigest {
+				update.UpgradeJob = last.UpgradeJob
+				continue
+			}
+			self.upgrade(app, update)
+		}
+	}
+
+	self.lock.Lock()
+	self.updates = updates
+	self.checkedAt = time.Now()
+	self.lock.Unlock()
+
+	return updates, nil
+}
+
+func (self *UpdateChecker) checkApp(update *ImageUpdate, digests map[string]string, failures map[string]error) error {
+	container, err := self.client.InspectContainer(update.ContainerId)
+	if err != nil {
+		return err
+	}
+	update.Image = container.Config.Image
+
+	ref, err := ParseImage(update.Image)
+	if err != nil {
+		return err
+	}
+
+	image, err := self.client.InspectImage(container.Image)
+	if err != nil {
+		return err
+	}
+	update.LocalDigest = repoDigest(image.RepoDigests, ref)
+	if update.LocalDigest == "" {
+		return fmt.Errorf("Image %s was not pulled from a registry", update.Image)
+	}
+
+	if _, ok := digests[update.Image]; !ok && failures[update.Image] == nil {
+		digest, err := self.registry.Digest(update.Image)
+		if err != nil {
+			failures[update.Image] = err
+		} else {
+			digests[update.Image] = digest
+		}
+	}
+	if failures[update.Image] != nil {
+		return failures[update.Image]
+	}
+
+	update.RemoteDigest = digests[update.Image]
+	update.UpdateAvailable = update.RemoteDigest != update.LocalDigest
+	return nil
+}
+
+func (self *UpdateChecker) upgrade(app *App, update *ImageUpdate) {
+	p, ok := self.lookup(app.Plugin).(appPlugin)
+	if !ok {
+		update.Error = fmt.Sprintf("No plugin named '%s' is enabled to upgrade the app", app.Plugin)
+		return
+	}
+
+	log.Infof("Upgrading %s automatically", update.ContainerName)
+	job := startUpgrade(p, UpgradeOpts{ContainerId: app.ContainerId})
+	update.UpgradeJob = job.Id
+}
+
+// appPolicy returns the update policy an app was installed with.
+func appPolicy(app *App) string {
+	policy, _ := app.Options["update_policy"].(string)
+	if !updatePolicies[policy] {
+		return PolicyNotify
+	}
+	return policy
+}
+
+// repoDigest finds the digest an image was pulled by for the repository of
+// ref, Docker records one for each repository the image was pulled from.
+func repoDigest(repoDigests []string, ref *ImageRef) string {
+	for _, repoDigest := range repoDigests {
+		parts := strings.SplitN(repoDigest, "@", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		other, err := ParseImage(parts[0])
+		if err == nil && other.Host == ref.Host && other.Repository == ref.Repository {
+			return parts[1]
+		}
+	}
+	return ""
+}
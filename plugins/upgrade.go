@@ -0,0 +1,155 @@
+package plugins
+
+import (
+	log "github.com/Sirupsen/logrus"
+	"github.com/bytesizedhosting/bcd/jobs"
+	"github.com/fsouza/go-dockerclient"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// How long an upgraded app gets to become healthy when the caller does not
+// say.
+const DefaultHealthTimeout = 2 * time.Minute
+
+// UpgradeResult describes an upgrade. The container id changes when a newer
+// image was found.
+type UpgradeResult struct {
+	ContainerId string `json:"container_id"`
+	Image       string `json:"image"`
+	OldImageId  string `json:"old_image_id"`
+	NewImageId  string `json:"new_image_id"`
+	Upgraded    bool   `json:"upgraded"`
+}
+
+// Upgrade pulls the image of a container and, when it changed, replaces the
+// container with one created from the new image using the same settings. The
+// old container is kept until the new one is healthy, when it is not the
+// job fails and rolls back to the old container. A stopped app is not
+// started.
+func (self *Base) Upgrade(containerId string, timeout time.Duration, job *jobs.Job) (*UpgradeResult, error) {
+	old, err := self.DockerClient.InspectContainer(containerId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &UpgradeResult{ContainerId: old.ID, Image: old.Config.Image, OldImageId: old.Image}
+	job.Logf("Container %s runs image %s", old.ID, old.Image)
+
+	opts := &BaseOpts{}
+	opts.SetJob(job)
+	err = self.PullImage(old.Config.Image, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	image, err := self.DockerClient.InspectImage(old.Config.Image)
+	if err != nil {
+		return nil, err
+	}
+	res.NewImageId = image.ID
+	if image.ID == old.Image {
+		job.Logf("%s is already up to date", old.Config.Image)
+		return res, nil
+	}
+
+	config := *old.Config
+	oldImage, err := self.DockerClient.InspectImage(old.Image)
+	if err == nil && oldImage.Config != nil {
+		withoutImageDefaults(&config, oldImage.Config)
+	} else {
+		log.Warnf("Could not inspect the old image %s, keeping all of its settings: %v", old.Image, err)
+	}
+	if strings.HasPrefix(old.ID, config.Hostname) {
+		config.Hostname = ""
+	}
+
+	job.Stage(jobs.StageReplace)
+	name := strings.TrimPrefix(old.Name, "/")
+
+	if old.State.Running {
+		err = self.DockerClient.StopContainer(old.ID, 10)
+		if err != nil {
+			return nil, err
+		}
+		job.OnRollback("stopping the old container", func() error {
+			return self.DockerClient.StartContainer(old.ID, nil)
+		})
+	}
+
+	err = self.DockerClient.RenameContainer(docker.RenameContainerOptions{ID: old.ID, Name: name + "_previous", Context: job.Context()})
+	if err != nil {
+		return nil, err
+	}
+	job.OnRollback("renaming the old container", func() error {
+		return self.DockerClient.RenameContainer(docker.RenameContainerOptions{ID: old.ID, Name: name})
+	})
+
+	created, err := self.DockerClient.CreateContainer(docker.CreateContainerOptions{Name: name, Config: &config, HostConfig: old.HostConfig, Context: job.Context()})
+	if err != nil {
+		return nil, err
+	}
+	job.OnRollback("creating the new container", func() error {
+		return self.DockerClient.RemoveContainer(docker.RemoveContainerOptions{ID: created.ID, Force: true})
+	})
+
+	// An app that was stopped stays stopped.
+	if old.State.Running {
+		job.Stage(jobs.StageStart)
+		err = self.DockerClient.StartContainer(created.ID, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		job.Stage(jobs.StageHealth)
+		if timeout <= 0 {
+			timeout = DefaultHealthTimeout
+		}
+		err = self.WaitHealthy(job.Context(), created.ID, timeout)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		job.Logf("The app was stopped, the new container is not started")
+	}
+
+	err = self.DockerClient.RemoveContainer(docker.RemoveContainerOptions{ID: old.ID, Force: true})
+	if err != nil {
+		log.Warnf("Could not remove the old container %s after the upgrade: %s", old.ID, err)
+		job.Logf("Could not remove the old container %s: %s", old.ID, err)
+	}
+
+	res.ContainerId = created.ID
+	res.Upgraded = true
+	return res, nil
+}
+
+// withoutImageDefaults removes the settings a container inherited from its
+// image, so a container created from a newer image gets that image's.
+func withoutImageDefaults(config *docker.Config, image *docker.Config) {
+	defaults := map[string]bool{}
+	for _, variable := range image.Env {
+		defaults[variable] = true
+	}
+	env := []string{}
+	for _, variable := range config.Env {
+		if !defaults[variable] {
+			env = append(env, variable)
+		}
+	}
+	config.Env = env
+
+	if reflect.DeepEqual(config.Cmd, image.Cmd) {
+		config.Cmd = nil
+	}
+	if reflect.DeepEqual(config.Entrypoint, image.Entrypoint) {
+		config.Entrypoint = nil
+	}
+	if config.WorkingDir == image.WorkingDir {
+		config.WorkingDir = ""
+	}
+	if config.User == image.User {
+		config.User = ""
+	}
+}
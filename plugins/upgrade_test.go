@@ -0,0 +1,121 @@
+package plugins
+
+import (
+	"github.com/bytesizedhosting/bcd/jobs"
+	"github.com/fsouza/go-dockerclient"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestUpgradeKeepsSettings(t *testing.T) {
+	image := &docker.Config{Env: []string{"PATH=/usr/bin", "VERSION=1"}, Cmd: []string{"/start"}, User: "abc"}
+	config := &docker.Config{Env: []string{"PATH=/usr/bin", "VERSION=1", "PUID=1000"}, Cmd: []string{"/start"}, User: "root",
+		Labels: map[string]string{LabelOptions: `{"web_port":"8080"}`}}
+
+	withoutImageDefaults(config, image)
+	if len(config.Env) != 1 || config.Env[0] != "PUID=1000" {
+		t.Errorf("Only the container's own environment should be kept, got: %v", config.Env)
+	}
+	if config.Cmd != nil || config.User != "root" {
+		t.Errorf("The image command should be dropped and the user kept, got: %v %s", config.Cmd, config.User)
+	}
+
+	container := &docker.Container{Config: config, HostConfig: &docker.HostConfig{
+		PortBindings: map[docker.Port][]docker.PortBinding{"9000/tcp": {{HostPort: "9000"}}}}}
+	if port := webPort(container); port != "8080" {
+		t.Errorf("Expected the web_port option to be probed, got '%s'", port)
+	}
+	container.Config.Labels = nil
+	if port := webPort(container); port != "9000" {
+		t.Errorf("Expected the published port to be probed, got '%s'", port)
+	}
+}
+
+func TestUpgradeRollsBack(t *testing.T) {
+	fake := &fakeDocker{replies: map[string]string{
+		"GET /containers/old/json": `{"Id": "old", "Name": "/bytesized_app_8080", "Image": "sha-old",
+			"Config": {"Image": "bytesized/app"}, "State": {"Running": true}, "HostConfig": {}}`,
+		"POST /images/create":            `{"status": "Downloaded newer image for bytesized/app"}`,
+		"GET /images/bytesized/app/json": `{"Id": "sha-new"}`,
+		"GET /images/sha-old/json":       `{"Id": "sha-old", "Config": {}}`,
+		"POST /containers/old/stop":      "",
+		"POST /containers/old/rename":    "",
+		"POST /containers/create":        `{"Id": "new"}`,
+		"POST /containers/new/start":     "",
+		// The new container dies right away.
+		"GET /containers/new/json":   `{"Id": "new", "State": {"Running": false, "ExitCode": 1}}`,
+		"DELETE /containers/new":     "",
+		"POST /containers/old/start": "",
+	}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client, err := docker.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := Base{DockerClient: client, Name: "app"}
+
+	job := jobs.New("app", nil, "")
+	res, err := b.Upgrade("old", time.Second, job)
+	if err == nil || res != nil {
+		t.Fatalf("Expected the upgrade to fail, got %+v", res)
+	}
+	job.Fail(err)
+
+	if !fake.called(
+		"POST /containers/old/stop",
+		"POST /containers/old/rename?name=bytesized_app_8080_previous",
+		"POST /containers/create?name=bytesized_app_8080",
+		"POST /containers/new/start",
+		"GET /containers/new/json",
+		"DELETE /containers/new",
+		"POST /containers/old/rename?name=bytesized_app_8080",
+		"POST /containers/old/start",
+	) {
+		t.Errorf("The old container was not put back, calls: %v", fake.calls)
+	}
+
+	snapshot := job.Snapshot()
+	if snapshot.Status != jobs.FAILED || len(snapshot.RolledBack) != 3 {
+		t.Errorf("Expected a failed job with 3 steps rolled back, got status %d: %+v", snapshot.Status, snapshot.RolledBack)
+	}
+	for _, undone := range snapshot.RolledBack {
+		if undone.Error != "" {
+			t.Errorf("Could not roll back %s: %s", undone.Step, undone.Error)
+		}
+	}
+}
+
+func TestUpgradeKeepsStoppedAppStopped(t *testing.T) {
+	fake := &fakeDocker{replies: map[string]string{
+		"GET /containers/old/json": `{"Id": "old", "Name": "/bytesized_app_8080", "Image": "sha-old",
+			"Config": {"Image": "bytesized/app"}, "State": {"Running": false}, "HostConfig": {}}`,
+		"POST /images/create":            `{"status": "Downloaded newer image for bytesized/app"}`,
+		"GET /images/bytesized/app/json": `{"Id": "sha-new"}`,
+		"GET /images/sha-old/json":       `{"Id": "sha-old", "Config": {}}`,
+		"POST /containers/old/rename":    "",
+		"POST /containers/create":        `{"Id": "new"}`,
+		"DELETE /containers/old":         "",
+	}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	client, err := docker.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := Base{DockerClient: client, Name: "app"}
+
+	res, err := b.Upgrade("old", time.Second, jobs.New("app", nil, ""))
+	if err != nil || !res.Upgraded || res.ContainerId != "new" {
+		t.Fatalf("Expected the upgrade to succeed, got %+v: %v", res, err)
+	}
+	if fake.called("POST /containers/new/start") || fake.called("POST /containers/old/stop") {
+		t.Errorf("A stopped app should stay stopped, calls: %v", fake.calls)
+	}
+	if !fake.called("POST /containers/create?name=bytesized_app_8080", "DELETE /containers/old") {
+		t.Errorf("Expected the old container to be replaced, calls: %v", fake.calls)
+	}
+}
@@ -0,0 +1,234 @@
+package plugins
+
+import (
+	"fmt"
+	"github.com/bytesizedhosting/bcd/core"
+	"path"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Option types a manifest can declare, options without a type are strings.
+const (
+	TypeString = "string"
+	TypeInt    = "int"
+	TypePort   = "port"
+	TypePath   = "path"
+	TypeBool   = "bool"
+	TypeEnum   = "enum"
+	TypeSecret = "secret"
+)
+
+// Options every method accepts, whether the manifest lists them or not.
+var commonOptions = mergeOptionNames(OptionNames(BaseOpts{}), OptionNames(ActionOpts{}), core.SecretOptionNames())
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidationError lists every option that did not pass validation.
+type ValidationError struct {
+	Method string       `json:"method"`
+	Fields []FieldError `json:"fields"`
+}
+
+func (self *ValidationError) Error() string {
+	problems := []string{}
+	for _, field := range self.Fields {
+		problems = append(problems, field.Field+" "+field.Message)
+	}
+	return fmt.Sprintf("Invalid options for %s: %s", self.Method, strings.Join(problems, ", "))
+}
+
+// SecretOptions returns the names of the options declared with the secret
+// type, for any method.
+func (self *Manifest) SecretOptions() []string {
+	if self == nil {
+		return nil
+	}
+
+	res := []string{}
+	for _, options := range self.MethodOptions {
+		for _, option := range options {
+			if option.Type == TypeSecret {
+				res = append(res, option.Name)
+			}
+		}
+	}
+	return res
+}
+
+// Validate checks the options of a call against the method options in the
+// manifest. Methods the manifest lists no options for are not checked.
+func (self *Manifest) Validate(method string, params map[string]interface{}) error {
+	options, ok := self.MethodOptions[method]
+	if !ok {
+		return nil
+	}
+
+	res := &ValidationError{Method: method}
+	declared := map[string]bool{}
+	for _, option := range options {
+		declared[option.Name] = true
+		value, present := params[option.Name]
+		if message := option.check(value, present); message != "" {
+			res.Fields = append(res.Fields, FieldError{Field: option.Name, Message: message})
+		}
+	}
+
+	unknown := []string{}
+	for key := range params {
+		if !declared[key] && !commonOptions[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+	for _, key := range unknown {
+		res.Fields = append(res.Fields, FieldError{Field: key, Message: "is not a known option"})
+	}
+
+	if len(res.Fields) > 0 {
+		return res
+	}
+	return nil
+}
+
+// check returns why a value is not valid for the option, or an empty string
+// when it is.
+func (self *MethodOption) check(raw interface{}, present bool) string {
+	if !present || raw == nil {
+		if self.Required {
+			return "is required"
+		}
+		return ""
+	}
+
+	var value string
+	switch v := raw.(type) {
+	case string:
+		value = v
+	case bool:
+		value = strconv.FormatBool(v)
+	case float64:
+		value = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return "must be a single value"
+	}
+
+	if value == "" {
+		if self.Required {
+			return "is required"
+		}
+		return ""
+	}
+
+	switch self.Type {
+	case TypeInt, TypePort:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return "must be a whole number"
+		}
+		if self.Type == TypePort && (n < 1 || n > 65535) {
+			return "must be a port between 1 and 65535"
+		}
+		if self.Min != nil && n < *self.Min {
+			return fmt.Sprintf("must be at least %d", *self.Min)
+		}
+		if self.Max != nil && n > *self.Max {
+			return fmt.Sprintf("must be at most %d", *self.Max)
+		}
+		return ""
+	case TypeBool, "boolean":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return "must be true or false"
+		}
+		return ""
+	case TypeEnum:
+		for _, allowed := range self.Values {
+			if value == allowed {
+				return ""
+			}
+		}
+		return "must be one of " + strings.Join(self.Values, ", ")
+	case TypePath:
+		if !path.IsAbs(value) {
+			return "must be an absolute path"
+		}
+		for _, part := range strings.Split(value, "/") {
+			if part == ".." {
+				return "must not contain '..'"
+			}
+		}
+	}
+
+	// Strings, secrets and paths: min and max limit the length.
+	if self.Min != nil && len(value) < *self.Min {
+		return fmt.Sprintf("must be at least %d characters", *self.Min)
+	}
+	if self.Max != nil && len(value) > *self.Max {
+		return fmt.Sprintf("must be at most %d characters", *self.Max)
+	}
+	if self.Pattern != "" {
+		matched, err := regexp.MatchString("^(?:"+self.Pattern+")$", value)
+		if err != nil {
+			return "can not be checked, the manifest pattern is invalid"
+		}
+		if !matched {
+			// Never echo the pattern for secrets, it can give away too much.
+			if self.Type == TypeSecret {
+				return "has an invalid format"
+			}
+			return "must match " + self.Pattern
+		}
+	}
+	return ""
+}
+
+// OptionNames returns the JSON names of all fields of an options struct,
+// including those of embedded structs.
+func OptionNames(opts interface{}) map[string]bool {
+	return optionNames(reflect.Indirect(reflect.ValueOf(opts)).Type())
+}
+
+func optionNames(t reflect.Type) map[string]bool {
+	names := map[string]bool{}
+	if t.Kind() != reflect.Struct {
+		return names
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			for name := range optionNames(field.Type) {
+				names[name] = true
+			}
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		names[name] = true
+	}
+	return names
+}
+
+func mergeOptionNames(sets ...map[string]bool) map[string]bool {
+	res := map[string]bool{}
+	for _, set := range sets {
+		for name := range set {
+			res[name] = true
+		}
+	}
+	return res
+}
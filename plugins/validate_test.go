@@ -0,0 +1,37 @@
+package plugins
+
+import (
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	manifest, err := LoadManifest("deluge")
+	if err != nil {
+		t.Fatal("Could not load Manifest:", err)
+	}
+
+	valid := map[string]interface{}{"web_port": "8112", "daemon_port": float64(58846), "config_folder": "/home/bytesized/config/deluge", "callback_url": "http://example.com"}
+	if err := manifest.Validate("Install", valid); err != nil {
+		t.Error("Valid options were rejected:", err)
+	}
+
+	invalid := map[string]interface{}{"web_port": "99999", "data_folder": "data", "media_folder": "/home/../etc", "colour": "red"}
+	err = manifest.Validate("Install", invalid)
+	res, ok := err.(*ValidationError)
+	if !ok || len(res.Fields) != 4 {
+		t.Fatal("Expected four failing fields, got:", err)
+	}
+	if res.Fields[len(res.Fields)-1].Field != "colour" {
+		t.Error("Unknown option was not reported last:", res.Fields)
+	}
+
+	min := 3
+	option := MethodOption{Name: "level", Type: TypeInt, Required: true, Min: &min}
+	if option.check("2", true) == "" || option.check(nil, false) == "" || option.check(float64(4), true) != "" {
+		t.Error("Int constraints are not applied")
+	}
+	option = MethodOption{Name: "mode", Type: TypeEnum, Values: []string{"copy", "move"}}
+	if option.check("link", true) == "" || option.check("move", true) != "" {
+		t.Error("Enum values are not applied")
+	}
+}
@@ -1,6 +1,7 @@
 package vnc
 
 import (
+	"fmt"
 	log "github.com/Sirupsen/logrus"
 	"github.com/bytesizedhosting/bcd/plugins"
 	"github.com/fsouza/go-dockerclient"
@@ -12,6 +13,16 @@ type Vnc struct {
 	imageName string
 }
 
+func init() {
+	plugins.Register("vnc", func(client *docker.Client) (plugins.Plugin, error) {
+		p, err := New(client)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
 func New(client *docker.Client) (*Vnc, error) {
 	manifest, err := plugins.LoadManifest("vnc")
 
@@ -47,7 +58,7 @@ func (self *Vnc) Install(opts *VncOpts) error {
 	}).Debug("Plugin options")
 
 	log.Debugln("Pulling docker image", self.imageName)
-	err = self.DockerClient.PullImage(docker.PullImageOptions{Repository: self.imageName}, docker.AuthConfiguration{})
+	err = self.PullImage(self.imageName, opts)
 	if err != nil {
 		return err
 	}
@@ -65,7 +76,7 @@ func (self *Vnc) Install(opts *VncOpts) error {
 	conf := docker.Config{Env: []string{"PUID=" + opts.User.Uid}, Image: self.imageName}
 
 	log.Debugln("Creating docker container")
-	c, err := self.DockerClient.CreateContainer(docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_vnc_" + opts.WebPort})
+	c, err := self.CreateContainer(opts, docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_vnc_" + opts.WebPort})
 
 	if err != nil {
 		return err
@@ -73,22 +84,45 @@ func (self *Vnc) Install(opts *VncOpts) error {
 
 	log.Debugln("Starting docker container")
 
-	err = self.DockerClient.StartContainer(c.ID, nil)
+	err = self.StartContainer(c.ID, opts)
 	if err != nil {
 		return err
 	}
 
-	exec, err := self.DockerClient.CreateExec(docker.CreateExecOptions{Cmd: []string{"exec", "s6-setuidgid", "bytesized", "/app/set_password", opts.Password}, Container: c.ID})
+	err = self.setPassword(c.ID, opts.Password)
 	if err != nil {
 		return err
 	}
 
-	err = self.DockerClient.StartExec(exec.ID, docker.StartExecOptions{})
+	opts.ContainerId = c.ID
+
+	return nil
+}
+
+// ChangePassword runs set_password in the container again, the container
+// has to be running for that.
+func (self *Vnc) ChangePassword(containerId string, password string) error {
+	container, err := self.LoadOptions(containerId, &VncOpts{})
 	if err != nil {
 		return err
 	}
+	if !container.State.Running {
+		return fmt.Errorf("Start the app before changing its password")
+	}
 
-	opts.ContainerId = c.ID
+	err = self.setPassword(container.ID, password)
+	if err != nil {
+		return err
+	}
 
-	return nil
+	return self.Restart(&plugins.AppConfig{ContainerId: containerId})
+}
+
+func (self *Vnc) setPassword(id string, password string) error {
+	exec, err := self.DockerClient.CreateExec(docker.CreateExecOptions{Cmd: []string{"exec", "s6-setuidgid", "bytesized", "/app/set_password", password}, Container: id})
+	if err != nil {
+		return err
+	}
+
+	return self.DockerClient.StartExec(exec.ID, docker.StartExecOptions{})
 }
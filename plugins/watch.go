@@ -0,0 +1,83 @@
+package plugins
+
+import (
+	log "github.com/Sirupsen/logrus"
+	"github.com/bytesizedhosting/bcd/events"
+	"github.com/fsouza/go-dockerclient"
+	"strings"
+)
+
+// Container actions that are passed on to event subscribers.
+var watchedActions = map[string]bool{
+	"create":  true,
+	"start":   true,
+	"stop":    true,
+	"restart": true,
+	"die":     true,
+	"kill":    true,
+	"oom":     true,
+	"destroy": true,
+}
+
+// ContainerEvent is published on the containers topic for our containers.
+type ContainerEvent struct {
+	ContainerId   string `json:"container_id"`
+	ContainerName string `json:"container_name"`
+	Plugin        string `json:"plugin,omitempty"`
+	Instance      string `json:"instance,omitempty"`
+	Action        string `json:"action"`
+	ExitCode      string `json:"exit_code,omitempty"`
+}
+
+// WatchContainers publishes Docker events of the containers created by bcd.
+// It blocks for as long as the Docker client delivers events.
+func WatchContainers(client *docker.Client) error {
+	listener := make(chan *docker.APIEvents, 64)
+	err := client.AddEventListener(listener)
+	if err != nil {
+		return err
+	}
+	defer client.RemoveEventListener(listener)
+
+	for msg := range listener {
+		event, ok := containerEvent(msg)
+		if ok {
+			Supervise.handle(client, event)
+			events.Publish(events.TopicContainers, "container."+event.Action, event)
+		}
+	}
+
+	log.Infoln("Docker event stream ended")
+	return nil
+}
+
+func containerEvent(msg *docker.APIEvents) (*ContainerEvent, bool) {
+	// Docker before API 1.22 only sends the status and the id, without the
+	// name and labels these events are skipped below.
+	action, id := msg.Action, msg.Actor.ID
+	if action == "" {
+		action, id = msg.Status, msg.ID
+	}
+	if msg.Type != "" && msg.Type != "container" {
+		return nil, false
+	}
+	if !watchedActions[action] {
+		return nil, false
+	}
+
+	attributes := msg.Actor.Attributes
+	name := attributes["name"]
+	if attributes[LabelPlugin] == "" && !strings.HasPrefix(name, ContainerPrefix) {
+		return nil, false
+	}
+
+	event := &ContainerEvent{
+		ContainerId:   id,
+		ContainerName: name,
+		Plugin:        attributes[LabelPlugin],
+		Instance:      attributes[LabelInstance],
+		Action:        action,
+		ExitCode:      attributes["exitCode"],
+	}
+	return event, true
+}
@@ -7,8 +7,10 @@ import (
 	"github.com/bytesizedhosting/bcd/core"
 	"github.com/bytesizedhosting/bcd/plugins"
 	"github.com/fsouza/go-dockerclient"
+	"io/ioutil"
 	"net/rpc"
 	"path"
+	"strings"
 )
 
 type Znc struct {
@@ -16,6 +18,16 @@ type Znc struct {
 	imageName string
 }
 
+func init() {
+	plugins.Register("znc", func(client *docker.Client) (plugins.Plugin, error) {
+		p, err := New(client)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+}
+
 func New(client *docker.Client) (*Znc, error) {
 	manifest, err := plugins.LoadManifest("znc")
 
@@ -70,7 +82,7 @@ func (self *Znc) Install(opts *ZncOpts) error {
 	}
 
 	log.Debugln("Pulling docker image", self.imageName)
-	err = self.DockerClient.PullImage(docker.PullImageOptions{Repository: self.imageName}, docker.AuthConfiguration{})
+	err = self.PullImage(self.imageName, opts)
 	if err != nil {
 		return err
 	}
@@ -87,7 +99,7 @@ func (self *Znc) Install(opts *ZncOpts) error {
 	conf := docker.Config{Env: []string{"PUID=" + opts.User.Uid}, Image: self.imageName}
 
 	log.Debugln("Creating docker container")
-	c, err := self.DockerClient.CreateContainer(docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_znc_" + opts.WebPort})
+	c, err := self.CreateContainer(opts, docker.CreateContainerOptions{Config: &conf, HostConfig: &hostConfig, Name: "bytesized_znc_" + opts.WebPort})
 
 	if err != nil {
 		return err
@@ -95,7 +107,7 @@ func (self *Znc) Install(opts *ZncOpts) error {
 
 	log.Debugln("Starting docker container")
 
-	err = self.DockerClient.StartContainer(c.ID, nil)
+	err = self.StartContainer(c.ID, opts)
 	if err != nil {
 		return err
 	}
@@ -104,3 +116,56 @@ func (self *Znc) Install(opts *ZncOpts) error {
 
 	return nil
 }
+
+// ChangePassword sets the password of the first user in znc.conf, that is
+// the user the app was installed with.
+func (self *Znc) ChangePassword(containerId string, password string) error {
+	opts := &ZncOpts{}
+	_, err := self.LoadOptions(containerId, opts)
+	if err != nil {
+		return err
+	}
+
+	opts.Password = password
+	opts.hashPassword()
+
+	err = setPass(path.Join(opts.ConfigFolder, "/configs/", "znc.conf"), opts.EncPassword)
+	if err != nil {
+		return err
+	}
+
+	return self.Restart(&plugins.AppConfig{ContainerId: containerId})
+}
+
+// setPass replaces the first password of a znc.conf. Once ZNC saved its
+// config the password is a <Pass password> block instead of a line, the
+// block is replaced by a line as ZNC reads both.
+func setPass(file string, hash string) error {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	lines := strings.Split(string(data), "\n")
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, "Pass ") && !strings.HasPrefix(trimmed, "Pass=") && trimmed != "<Pass password>" {
+			continue
+		}
+
+		end := i
+		if trimmed == "<Pass password>" {
+			for end < len(lines) && strings.TrimSpace(lines[end]) != "</Pass>" {
+				end++
+			}
+			if end == len(lines) {
+				return fmt.Errorf("The <Pass password> block in %s is not closed", file)
+			}
+		}
+
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		lines = append(lines[:i], append([]string{indent + "Pass       = sha256#" + hash}, lines[end+1:]...)...)
+		return plugins.ReplaceFile(file, []byte(strings.Join(lines, "\n")))
+	}
+	return fmt.Errorf("Could not find a password in %s", file)
+}